@@ -100,6 +100,10 @@ type VectorStore struct {
 	// integer or null. The Unix timestamp (in seconds) for when the vector store was last active.
 	LastActiveAt int64             `protobuf:"varint,10,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
 	Metadata     map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IndexConfig  *IndexConfig      `protobuf:"bytes,12,opt,name=index_config,json=indexConfig,proto3" json:"index_config,omitempty"`
+	// The model used to embed the files and queries of the vector store.
+	EmbeddingModel      string `protobuf:"bytes,13,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	EmbeddingDimensions int32  `protobuf:"varint,14,opt,name=embedding_dimensions,json=embeddingDimensions,proto3" json:"embedding_dimensions,omitempty"`
 }
 
 func (x *VectorStore) Reset() {
@@ -211,19 +215,157 @@ func (x *VectorStore) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *VectorStore) GetIndexConfig() *IndexConfig {
+	if x != nil {
+		return x.IndexConfig
+	}
+	return nil
+}
+
+func (x *VectorStore) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+func (x *VectorStore) GetEmbeddingDimensions() int32 {
+	if x != nil {
+		return x.EmbeddingDimensions
+	}
+	return 0
+}
+
+// IndexConfig is the configuration of the vector index of a vector store. Unset parameters use the defaults.
+type IndexConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of L2, IP or COSINE. Defaults to L2.
+	MetricType string `protobuf:"bytes,1,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	// One of FLAT, IVF_FLAT, IVF_SQ8, HNSW or DISKANN. Defaults to IVF_FLAT.
+	IndexType string `protobuf:"bytes,2,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	// The number of cluster units of IVF_FLAT and IVF_SQ8. Defaults to 128.
+	Nlist int32 `protobuf:"varint,3,opt,name=nlist,proto3" json:"nlist,omitempty"`
+	// The number of cluster units to search with IVF_FLAT and IVF_SQ8. Defaults to 16.
+	Nprobe int32 `protobuf:"varint,4,opt,name=nprobe,proto3" json:"nprobe,omitempty"`
+	// The maximum degree of nodes of HNSW. Defaults to 16.
+	M int32 `protobuf:"varint,5,opt,name=m,proto3" json:"m,omitempty"`
+	// The size of the candidate list when building HNSW. Defaults to 200.
+	EfConstruction int32 `protobuf:"varint,6,opt,name=ef_construction,json=efConstruction,proto3" json:"ef_construction,omitempty"`
+	// The size of the candidate list when searching HNSW. Defaults to 64.
+	Ef int32 `protobuf:"varint,7,opt,name=ef,proto3" json:"ef,omitempty"`
+	// The size of the candidate list when searching DISKANN. Defaults to 100.
+	SearchList int32 `protobuf:"varint,8,opt,name=search_list,json=searchList,proto3" json:"search_list,omitempty"`
+}
+
+func (x *IndexConfig) Reset() {
+	*x = IndexConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexConfig) ProtoMessage() {}
+
+func (x *IndexConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexConfig.ProtoReflect.Descriptor instead.
+func (*IndexConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IndexConfig) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
+	return ""
+}
+
+func (x *IndexConfig) GetIndexType() string {
+	if x != nil {
+		return x.IndexType
+	}
+	return ""
+}
+
+func (x *IndexConfig) GetNlist() int32 {
+	if x != nil {
+		return x.Nlist
+	}
+	return 0
+}
+
+func (x *IndexConfig) GetNprobe() int32 {
+	if x != nil {
+		return x.Nprobe
+	}
+	return 0
+}
+
+func (x *IndexConfig) GetM() int32 {
+	if x != nil {
+		return x.M
+	}
+	return 0
+}
+
+func (x *IndexConfig) GetEfConstruction() int32 {
+	if x != nil {
+		return x.EfConstruction
+	}
+	return 0
+}
+
+func (x *IndexConfig) GetEf() int32 {
+	if x != nil {
+		return x.Ef
+	}
+	return 0
+}
+
+func (x *IndexConfig) GetSearchList() int32 {
+	if x != nil {
+		return x.SearchList
+	}
+	return 0
+}
+
 type ChunkingStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// One of auto, static, or semantic. The auto strategy chooses how to split a file from its type and the context
+	// length of the embedding model. The semantic strategy starts a new chunk where the topic of the text shifts.
 	Type   string                   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Static *ChunkingStrategy_Static `protobuf:"bytes,2,opt,name=static,proto3" json:"static,omitempty"`
+	// The parameters chosen by the auto strategy. This is set only in responses once the file is processed.
+	Auto     *ChunkingStrategy_Auto     `protobuf:"bytes,3,opt,name=auto,proto3" json:"auto,omitempty"`
+	Semantic *ChunkingStrategy_Semantic `protobuf:"bytes,4,opt,name=semantic,proto3" json:"semantic,omitempty"`
 }
 
 func (x *ChunkingStrategy) Reset() {
 	*x = ChunkingStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[2]
+		mi := &file_api_v1_vector_store_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +378,7 @@ func (x *ChunkingStrategy) String() string {
 func (*ChunkingStrategy) ProtoMessage() {}
 
 func (x *ChunkingStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[2]
+	mi := &file_api_v1_vector_store_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +391,7 @@ func (x *ChunkingStrategy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChunkingStrategy.ProtoReflect.Descriptor instead.
 func (*ChunkingStrategy) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ChunkingStrategy) GetType() string {
@@ -266,6 +408,20 @@ func (x *ChunkingStrategy) GetStatic() *ChunkingStrategy_Static {
 	return nil
 }
 
+func (x *ChunkingStrategy) GetAuto() *ChunkingStrategy_Auto {
+	if x != nil {
+		return x.Auto
+	}
+	return nil
+}
+
+func (x *ChunkingStrategy) GetSemantic() *ChunkingStrategy_Semantic {
+	if x != nil {
+		return x.Semantic
+	}
+	return nil
+}
+
 type CreateVectorStoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -276,12 +432,17 @@ type CreateVectorStoreRequest struct {
 	ExpiresAfter     *ExpiresAfter     `protobuf:"bytes,3,opt,name=expires_after,json=expiresAfter,proto3" json:"expires_after,omitempty"`
 	ChunkingStrategy *ChunkingStrategy `protobuf:"bytes,4,opt,name=chunking_strategy,json=chunkingStrategy,proto3" json:"chunking_strategy,omitempty"`
 	Metadata         map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The configuration of the vector index. This cannot be changed after the vector store is created.
+	IndexConfig *IndexConfig `protobuf:"bytes,6,opt,name=index_config,json=indexConfig,proto3" json:"index_config,omitempty"`
+	// The model used to embed the files and queries of the vector store. The default model is used if not set.
+	// This cannot be changed after the vector store is created.
+	EmbeddingModel string `protobuf:"bytes,7,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
 }
 
 func (x *CreateVectorStoreRequest) Reset() {
 	*x = CreateVectorStoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[3]
+		mi := &file_api_v1_vector_store_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +455,7 @@ func (x *CreateVectorStoreRequest) String() string {
 func (*CreateVectorStoreRequest) ProtoMessage() {}
 
 func (x *CreateVectorStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[3]
+	mi := &file_api_v1_vector_store_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +468,7 @@ func (x *CreateVectorStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVectorStoreRequest.ProtoReflect.Descriptor instead.
 func (*CreateVectorStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateVectorStoreRequest) GetFileIds() []string {
@@ -345,6 +506,20 @@ func (x *CreateVectorStoreRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateVectorStoreRequest) GetIndexConfig() *IndexConfig {
+	if x != nil {
+		return x.IndexConfig
+	}
+	return nil
+}
+
+func (x *CreateVectorStoreRequest) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
 type ListVectorStoresRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -359,7 +534,7 @@ type ListVectorStoresRequest struct {
 func (x *ListVectorStoresRequest) Reset() {
 	*x = ListVectorStoresRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[4]
+		mi := &file_api_v1_vector_store_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +547,7 @@ func (x *ListVectorStoresRequest) String() string {
 func (*ListVectorStoresRequest) ProtoMessage() {}
 
 func (x *ListVectorStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[4]
+	mi := &file_api_v1_vector_store_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +560,7 @@ func (x *ListVectorStoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVectorStoresRequest.ProtoReflect.Descriptor instead.
 func (*ListVectorStoresRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListVectorStoresRequest) GetLimit() int32 {
@@ -431,7 +606,7 @@ type ListVectorStoresResponse struct {
 func (x *ListVectorStoresResponse) Reset() {
 	*x = ListVectorStoresResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[5]
+		mi := &file_api_v1_vector_store_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +619,7 @@ func (x *ListVectorStoresResponse) String() string {
 func (*ListVectorStoresResponse) ProtoMessage() {}
 
 func (x *ListVectorStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[5]
+	mi := &file_api_v1_vector_store_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +632,7 @@ func (x *ListVectorStoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVectorStoresResponse.ProtoReflect.Descriptor instead.
 func (*ListVectorStoresResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListVectorStoresResponse) GetObject() string {
@@ -506,7 +681,7 @@ type GetVectorStoreRequest struct {
 func (x *GetVectorStoreRequest) Reset() {
 	*x = GetVectorStoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[6]
+		mi := &file_api_v1_vector_store_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +694,7 @@ func (x *GetVectorStoreRequest) String() string {
 func (*GetVectorStoreRequest) ProtoMessage() {}
 
 func (x *GetVectorStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[6]
+	mi := &file_api_v1_vector_store_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +707,7 @@ func (x *GetVectorStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorStoreRequest.ProtoReflect.Descriptor instead.
 func (*GetVectorStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetVectorStoreRequest) GetId() string {
@@ -553,7 +728,7 @@ type GetVectorStoreByNameRequest struct {
 func (x *GetVectorStoreByNameRequest) Reset() {
 	*x = GetVectorStoreByNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[7]
+		mi := &file_api_v1_vector_store_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +741,7 @@ func (x *GetVectorStoreByNameRequest) String() string {
 func (*GetVectorStoreByNameRequest) ProtoMessage() {}
 
 func (x *GetVectorStoreByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[7]
+	mi := &file_api_v1_vector_store_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +754,7 @@ func (x *GetVectorStoreByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorStoreByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetVectorStoreByNameRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetVectorStoreByNameRequest) GetName() string {
@@ -604,7 +779,7 @@ type UpdateVectorStoreRequest struct {
 func (x *UpdateVectorStoreRequest) Reset() {
 	*x = UpdateVectorStoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[8]
+		mi := &file_api_v1_vector_store_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +792,7 @@ func (x *UpdateVectorStoreRequest) String() string {
 func (*UpdateVectorStoreRequest) ProtoMessage() {}
 
 func (x *UpdateVectorStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[8]
+	mi := &file_api_v1_vector_store_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +805,7 @@ func (x *UpdateVectorStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVectorStoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVectorStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateVectorStoreRequest) GetId() string {
@@ -672,7 +847,7 @@ type DeleteVectorStoreRequest struct {
 func (x *DeleteVectorStoreRequest) Reset() {
 	*x = DeleteVectorStoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[9]
+		mi := &file_api_v1_vector_store_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +860,7 @@ func (x *DeleteVectorStoreRequest) String() string {
 func (*DeleteVectorStoreRequest) ProtoMessage() {}
 
 func (x *DeleteVectorStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[9]
+	mi := &file_api_v1_vector_store_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +873,7 @@ func (x *DeleteVectorStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVectorStoreRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVectorStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteVectorStoreRequest) GetId() string {
@@ -721,7 +896,7 @@ type DeleteVectorStoreResponse struct {
 func (x *DeleteVectorStoreResponse) Reset() {
 	*x = DeleteVectorStoreResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[10]
+		mi := &file_api_v1_vector_store_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -734,7 +909,7 @@ func (x *DeleteVectorStoreResponse) String() string {
 func (*DeleteVectorStoreResponse) ProtoMessage() {}
 
 func (x *DeleteVectorStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[10]
+	mi := &file_api_v1_vector_store_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +922,7 @@ func (x *DeleteVectorStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVectorStoreResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVectorStoreResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteVectorStoreResponse) GetId() string {
@@ -789,12 +964,14 @@ type VectorStoreFile struct {
 	// Error or null.
 	LastError        *VectorStoreFile_Error `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	ChunkingStrategy *ChunkingStrategy      `protobuf:"bytes,8,opt,name=chunking_strategy,json=chunkingStrategy,proto3" json:"chunking_strategy,omitempty"`
+	// Key-value pairs attached to the file. They can be used to filter search results.
+	Attributes map[string]string `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *VectorStoreFile) Reset() {
 	*x = VectorStoreFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[11]
+		mi := &file_api_v1_vector_store_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +984,7 @@ func (x *VectorStoreFile) String() string {
 func (*VectorStoreFile) ProtoMessage() {}
 
 func (x *VectorStoreFile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[11]
+	mi := &file_api_v1_vector_store_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +997,7 @@ func (x *VectorStoreFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorStoreFile.ProtoReflect.Descriptor instead.
 func (*VectorStoreFile) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *VectorStoreFile) GetId() string {
@@ -879,6 +1056,13 @@ func (x *VectorStoreFile) GetChunkingStrategy() *ChunkingStrategy {
 	return nil
 }
 
+func (x *VectorStoreFile) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type CreateVectorStoreFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -887,12 +1071,15 @@ type CreateVectorStoreFileRequest struct {
 	VectorStoreId    string            `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
 	FileId           string            `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
 	ChunkingStrategy *ChunkingStrategy `protobuf:"bytes,3,opt,name=chunking_strategy,json=chunkingStrategy,proto3" json:"chunking_strategy,omitempty"`
+	// Key-value pairs attached to the file. Up to 16 pairs are allowed. Keys can be up to 64 characters and
+	// values can be up to 512 characters. Values that look like numbers or booleans are compared as such in filters.
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CreateVectorStoreFileRequest) Reset() {
 	*x = CreateVectorStoreFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[12]
+		mi := &file_api_v1_vector_store_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1092,7 @@ func (x *CreateVectorStoreFileRequest) String() string {
 func (*CreateVectorStoreFileRequest) ProtoMessage() {}
 
 func (x *CreateVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[12]
+	mi := &file_api_v1_vector_store_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1105,7 @@ func (x *CreateVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVectorStoreFileRequest.ProtoReflect.Descriptor instead.
 func (*CreateVectorStoreFileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateVectorStoreFileRequest) GetVectorStoreId() string {
@@ -942,6 +1129,13 @@ func (x *CreateVectorStoreFileRequest) GetChunkingStrategy() *ChunkingStrategy {
 	return nil
 }
 
+func (x *CreateVectorStoreFileRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type ListVectorStoreFilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -959,7 +1153,7 @@ type ListVectorStoreFilesRequest struct {
 func (x *ListVectorStoreFilesRequest) Reset() {
 	*x = ListVectorStoreFilesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[13]
+		mi := &file_api_v1_vector_store_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -972,7 +1166,7 @@ func (x *ListVectorStoreFilesRequest) String() string {
 func (*ListVectorStoreFilesRequest) ProtoMessage() {}
 
 func (x *ListVectorStoreFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[13]
+	mi := &file_api_v1_vector_store_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +1179,7 @@ func (x *ListVectorStoreFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVectorStoreFilesRequest.ProtoReflect.Descriptor instead.
 func (*ListVectorStoreFilesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListVectorStoreFilesRequest) GetVectorStoreId() string {
@@ -1045,7 +1239,7 @@ type ListVectorStoreFilesResponse struct {
 func (x *ListVectorStoreFilesResponse) Reset() {
 	*x = ListVectorStoreFilesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[14]
+		mi := &file_api_v1_vector_store_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1058,7 +1252,7 @@ func (x *ListVectorStoreFilesResponse) String() string {
 func (*ListVectorStoreFilesResponse) ProtoMessage() {}
 
 func (x *ListVectorStoreFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[14]
+	mi := &file_api_v1_vector_store_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,7 +1265,7 @@ func (x *ListVectorStoreFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVectorStoreFilesResponse.ProtoReflect.Descriptor instead.
 func (*ListVectorStoreFilesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListVectorStoreFilesResponse) GetObject() string {
@@ -1121,7 +1315,7 @@ type GetVectorStoreFileRequest struct {
 func (x *GetVectorStoreFileRequest) Reset() {
 	*x = GetVectorStoreFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[15]
+		mi := &file_api_v1_vector_store_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1328,7 @@ func (x *GetVectorStoreFileRequest) String() string {
 func (*GetVectorStoreFileRequest) ProtoMessage() {}
 
 func (x *GetVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[15]
+	mi := &file_api_v1_vector_store_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1341,7 @@ func (x *GetVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorStoreFileRequest.ProtoReflect.Descriptor instead.
 func (*GetVectorStoreFileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetVectorStoreFileRequest) GetVectorStoreId() string {
@@ -1176,7 +1370,7 @@ type DeleteVectorStoreFileRequest struct {
 func (x *DeleteVectorStoreFileRequest) Reset() {
 	*x = DeleteVectorStoreFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[16]
+		mi := &file_api_v1_vector_store_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1189,7 +1383,7 @@ func (x *DeleteVectorStoreFileRequest) String() string {
 func (*DeleteVectorStoreFileRequest) ProtoMessage() {}
 
 func (x *DeleteVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[16]
+	mi := &file_api_v1_vector_store_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1396,7 @@ func (x *DeleteVectorStoreFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVectorStoreFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVectorStoreFileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteVectorStoreFileRequest) GetVectorStoreId() string {
@@ -1232,7 +1426,7 @@ type DeleteVectorStoreFileResponse struct {
 func (x *DeleteVectorStoreFileResponse) Reset() {
 	*x = DeleteVectorStoreFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[17]
+		mi := &file_api_v1_vector_store_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1245,7 +1439,7 @@ func (x *DeleteVectorStoreFileResponse) String() string {
 func (*DeleteVectorStoreFileResponse) ProtoMessage() {}
 
 func (x *DeleteVectorStoreFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[17]
+	mi := &file_api_v1_vector_store_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1452,7 @@ func (x *DeleteVectorStoreFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVectorStoreFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVectorStoreFileResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteVectorStoreFileResponse) GetId() string {
@@ -1282,33 +1476,38 @@ func (x *DeleteVectorStoreFileResponse) GetDeleted() bool {
 	return false
 }
 
-type SearchVectorStoreRequest struct {
+type VectorStoreFileBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
-	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	NumDocuments  int32  `protobuf:"varint,3,opt,name=num_documents,json=numDocuments,proto3" json:"num_documents,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Object string `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// The Unix timestamp (in seconds) for when the vector store files batch was created.
+	CreatedAt     int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VectorStoreId string `protobuf:"bytes,4,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	// The status of the vector store files batch, which can be either in_progress, completed, cancelled or failed.
+	Status     string                           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FileCounts *VectorStoreFileBatch_FileCounts `protobuf:"bytes,6,opt,name=file_counts,json=fileCounts,proto3" json:"file_counts,omitempty"`
 }
 
-func (x *SearchVectorStoreRequest) Reset() {
-	*x = SearchVectorStoreRequest{}
+func (x *VectorStoreFileBatch) Reset() {
+	*x = VectorStoreFileBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[18]
+		mi := &file_api_v1_vector_store_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchVectorStoreRequest) String() string {
+func (x *VectorStoreFileBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchVectorStoreRequest) ProtoMessage() {}
+func (*VectorStoreFileBatch) ProtoMessage() {}
 
-func (x *SearchVectorStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[18]
+func (x *VectorStoreFileBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,46 +1518,968 @@ func (x *SearchVectorStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchVectorStoreRequest.ProtoReflect.Descriptor instead.
-func (*SearchVectorStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use VectorStoreFileBatch.ProtoReflect.Descriptor instead.
+func (*VectorStoreFileBatch) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SearchVectorStoreRequest) GetVectorStoreId() string {
+func (x *VectorStoreFileBatch) GetId() string {
 	if x != nil {
-		return x.VectorStoreId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchVectorStoreRequest) GetQuery() string {
+func (x *VectorStoreFileBatch) GetObject() string {
 	if x != nil {
-		return x.Query
+		return x.Object
 	}
 	return ""
 }
 
-func (x *SearchVectorStoreRequest) GetNumDocuments() int32 {
+func (x *VectorStoreFileBatch) GetCreatedAt() int64 {
 	if x != nil {
-		return x.NumDocuments
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type SearchVectorStoreResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Documents []string `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+func (x *VectorStoreFileBatch) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
 }
 
-func (x *SearchVectorStoreResponse) Reset() {
-	*x = SearchVectorStoreResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *VectorStoreFileBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VectorStoreFileBatch) GetFileCounts() *VectorStoreFileBatch_FileCounts {
+	if x != nil {
+		return x.FileCounts
+	}
+	return nil
+}
+
+type CreateVectorStoreFileBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId    string            `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	FileIds          []string          `protobuf:"bytes,2,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	ChunkingStrategy *ChunkingStrategy `protobuf:"bytes,3,opt,name=chunking_strategy,json=chunkingStrategy,proto3" json:"chunking_strategy,omitempty"`
+	// Key-value pairs attached to all files in the batch.
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CreateVectorStoreFileBatchRequest) Reset() {
+	*x = CreateVectorStoreFileBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVectorStoreFileBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVectorStoreFileBatchRequest) ProtoMessage() {}
+
+func (x *CreateVectorStoreFileBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVectorStoreFileBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateVectorStoreFileBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateVectorStoreFileBatchRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *CreateVectorStoreFileBatchRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+func (x *CreateVectorStoreFileBatchRequest) GetChunkingStrategy() *ChunkingStrategy {
+	if x != nil {
+		return x.ChunkingStrategy
+	}
+	return nil
+}
+
+func (x *CreateVectorStoreFileBatchRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetVectorStoreFileBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	BatchId       string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *GetVectorStoreFileBatchRequest) Reset() {
+	*x = GetVectorStoreFileBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVectorStoreFileBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVectorStoreFileBatchRequest) ProtoMessage() {}
+
+func (x *GetVectorStoreFileBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVectorStoreFileBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetVectorStoreFileBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetVectorStoreFileBatchRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *GetVectorStoreFileBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type CancelVectorStoreFileBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	BatchId       string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *CancelVectorStoreFileBatchRequest) Reset() {
+	*x = CancelVectorStoreFileBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelVectorStoreFileBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVectorStoreFileBatchRequest) ProtoMessage() {}
+
+func (x *CancelVectorStoreFileBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVectorStoreFileBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelVectorStoreFileBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelVectorStoreFileBatchRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *CancelVectorStoreFileBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type ListFilesInVectorStoreBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	BatchId       string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order         string `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	After         string `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	Before        string `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// Filter by file status. One of in_progress, completed, failed, cancelled.
+	Filter string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) Reset() {
+	*x = ListFilesInVectorStoreBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesInVectorStoreBatchRequest) ProtoMessage() {}
+
+func (x *ListFilesInVectorStoreBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesInVectorStoreBatchRequest.ProtoReflect.Descriptor instead.
+func (*ListFilesInVectorStoreBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *ListFilesInVectorStoreBatchRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// Filter is a filter on file attributes.
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of eq, ne, gt, gte, lt, lte for a comparison filter, or and, or for a compound filter.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The attribute key to compare. Used by a comparison filter.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The value to compare against. Used by a comparison filter.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The filters to combine. Used by a compound filter.
+	Filters []*Filter `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Filter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Filter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Filter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Filter) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type VectorStoreSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	// The query string for the search.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of results to return. This number should be between 1 and 50 inclusive.
+	MaxNumResults  int32                                    `protobuf:"varint,3,opt,name=max_num_results,json=maxNumResults,proto3" json:"max_num_results,omitempty"`
+	RankingOptions *VectorStoreSearchRequest_RankingOptions `protobuf:"bytes,4,opt,name=ranking_options,json=rankingOptions,proto3" json:"ranking_options,omitempty"`
+	// Whether to remove English stop words and punctuation from the query. Quoted phrases and queries with non-ASCII letters are kept as is.
+	RewriteQuery bool `protobuf:"varint,5,opt,name=rewrite_query,json=rewriteQuery,proto3" json:"rewrite_query,omitempty"`
+	// A filter to apply based on file attributes.
+	Filters *Filter `protobuf:"bytes,6,opt,name=filters,proto3" json:"filters,omitempty"`
+	// One of dense, sparse or hybrid. Dense search matches the embeddings of the query, sparse search matches
+	// the keywords in the query, and hybrid search fuses both with reciprocal rank fusion. Defaults to dense.
+	SearchMode string `protobuf:"bytes,7,opt,name=search_mode,json=searchMode,proto3" json:"search_mode,omitempty"`
+}
+
+func (x *VectorStoreSearchRequest) Reset() {
+	*x = VectorStoreSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorStoreSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorStoreSearchRequest) ProtoMessage() {}
+
+func (x *VectorStoreSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorStoreSearchRequest.ProtoReflect.Descriptor instead.
+func (*VectorStoreSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VectorStoreSearchRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchRequest) GetMaxNumResults() int32 {
+	if x != nil {
+		return x.MaxNumResults
+	}
+	return 0
+}
+
+func (x *VectorStoreSearchRequest) GetRankingOptions() *VectorStoreSearchRequest_RankingOptions {
+	if x != nil {
+		return x.RankingOptions
+	}
+	return nil
+}
+
+func (x *VectorStoreSearchRequest) GetRewriteQuery() bool {
+	if x != nil {
+		return x.RewriteQuery
+	}
+	return false
+}
+
+func (x *VectorStoreSearchRequest) GetFilters() *Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *VectorStoreSearchRequest) GetSearchMode() string {
+	if x != nil {
+		return x.SearchMode
+	}
+	return ""
+}
+
+// ChunkMetadata is the location of a chunk in the file that it is extracted from.
+type ChunkMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 1-based number of the page (or slide) of the chunk. 0 if the file does not have pages.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// The headings of the sections that contain the chunk, from the outermost one.
+	Headings []string `protobuf:"bytes,2,rep,name=headings,proto3" json:"headings,omitempty"`
+	// The 0-based index of the chunk in the file.
+	ChunkIndex int32 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	// The character offsets of the chunk in the text extracted from the file, where pages are separated by a newline.
+	// end_offset is exclusive. Both are 0 if the chunk cannot be located in the text.
+	StartOffset int32 `protobuf:"varint,4,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   int32 `protobuf:"varint,5,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+}
+
+func (x *ChunkMetadata) Reset() {
+	*x = ChunkMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkMetadata) ProtoMessage() {}
+
+func (x *ChunkMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkMetadata.ProtoReflect.Descriptor instead.
+func (*ChunkMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChunkMetadata) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ChunkMetadata) GetHeadings() []string {
+	if x != nil {
+		return x.Headings
+	}
+	return nil
+}
+
+func (x *ChunkMetadata) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *ChunkMetadata) GetStartOffset() int32 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *ChunkMetadata) GetEndOffset() int32 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+type VectorStoreSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId   string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The similarity score of the result. A higher score means more similar.
+	Score   float32                            `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	Content []*VectorStoreSearchResult_Content `protobuf:"bytes,4,rep,name=content,proto3" json:"content,omitempty"`
+	// The metadata of the chunk. This is not set for chunks added before chunk metadata was supported.
+	Metadata *ChunkMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *VectorStoreSearchResult) Reset() {
+	*x = VectorStoreSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorStoreSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorStoreSearchResult) ProtoMessage() {}
+
+func (x *VectorStoreSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorStoreSearchResult.ProtoReflect.Descriptor instead.
+func (*VectorStoreSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VectorStoreSearchResult) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchResult) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VectorStoreSearchResult) GetContent() []*VectorStoreSearchResult_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *VectorStoreSearchResult) GetMetadata() *ChunkMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type VectorStoreSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// The query used for the search. This is different from the requested query when the query is rewritten.
+	SearchQuery string                     `protobuf:"bytes,2,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`
+	Data        []*VectorStoreSearchResult `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	HasMore     bool                       `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// string or null.
+	NextPage string `protobuf:"bytes,5,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+}
+
+func (x *VectorStoreSearchResponse) Reset() {
+	*x = VectorStoreSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorStoreSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorStoreSearchResponse) ProtoMessage() {}
+
+func (x *VectorStoreSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorStoreSearchResponse.ProtoReflect.Descriptor instead.
+func (*VectorStoreSearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VectorStoreSearchResponse) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchResponse) GetSearchQuery() string {
+	if x != nil {
+		return x.SearchQuery
+	}
+	return ""
+}
+
+func (x *VectorStoreSearchResponse) GetData() []*VectorStoreSearchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *VectorStoreSearchResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *VectorStoreSearchResponse) GetNextPage() string {
+	if x != nil {
+		return x.NextPage
+	}
+	return ""
+}
+
+type SearchVectorStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorStoreId string `protobuf:"bytes,1,opt,name=vector_store_id,json=vectorStoreId,proto3" json:"vector_store_id,omitempty"`
+	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	NumDocuments  int32  `protobuf:"varint,3,opt,name=num_documents,json=numDocuments,proto3" json:"num_documents,omitempty"`
+	// The ID of the project that the caller belongs to. The vector store must belong to the project.
+	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// The ID of the tenant that the caller belongs to. The vector store must belong to the tenant.
+	TenantId string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// A filter to apply based on file attributes.
+	Filters *Filter `protobuf:"bytes,6,opt,name=filters,proto3" json:"filters,omitempty"`
+	// One of dense, sparse or hybrid. Defaults to dense.
+	SearchMode string `protobuf:"bytes,7,opt,name=search_mode,json=searchMode,proto3" json:"search_mode,omitempty"`
+	// Whether to rerank the results with the configured reranker.
+	Rerank bool `protobuf:"varint,8,opt,name=rerank,proto3" json:"rerank,omitempty"`
+}
+
+func (x *SearchVectorStoreRequest) Reset() {
+	*x = SearchVectorStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchVectorStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVectorStoreRequest) ProtoMessage() {}
+
+func (x *SearchVectorStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVectorStoreRequest.ProtoReflect.Descriptor instead.
+func (*SearchVectorStoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchVectorStoreRequest) GetVectorStoreId() string {
+	if x != nil {
+		return x.VectorStoreId
+	}
+	return ""
+}
+
+func (x *SearchVectorStoreRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchVectorStoreRequest) GetNumDocuments() int32 {
+	if x != nil {
+		return x.NumDocuments
+	}
+	return 0
+}
+
+func (x *SearchVectorStoreRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchVectorStoreRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SearchVectorStoreRequest) GetFilters() *Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SearchVectorStoreRequest) GetSearchMode() string {
+	if x != nil {
+		return x.SearchMode
+	}
+	return ""
+}
+
+func (x *SearchVectorStoreRequest) GetRerank() bool {
+	if x != nil {
+		return x.Rerank
+	}
+	return false
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId  string `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	FileId   string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The similarity score of the chunk to the query. A higher score means more similar.
+	Score float32 `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	Text  string  `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// The metadata of the chunk. This is not set for chunks added before chunk metadata was supported.
+	Metadata *ChunkMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchResult) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchResult) GetMetadata() *ChunkMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SearchVectorStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The texts of the matched chunks. This has the same order as results and is kept for backward compatibility.
+	Documents []string        `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Results   []*SearchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchVectorStoreResponse) Reset() {
+	*x = SearchVectorStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -1366,10 +2487,144 @@ func (x *SearchVectorStoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchVectorStoreResponse) ProtoMessage() {}
+func (*SearchVectorStoreResponse) ProtoMessage() {}
+
+func (x *SearchVectorStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVectorStoreResponse.ProtoReflect.Descriptor instead.
+func (*SearchVectorStoreResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchVectorStoreResponse) GetDocuments() []string {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *SearchVectorStoreResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type VectorStore_FileCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InProgress int64 `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	Completed  int64 `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed     int64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled  int64 `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Total      int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *VectorStore_FileCounts) Reset() {
+	*x = VectorStore_FileCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorStore_FileCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorStore_FileCounts) ProtoMessage() {}
+
+func (x *VectorStore_FileCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorStore_FileCounts.ProtoReflect.Descriptor instead.
+func (*VectorStore_FileCounts) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *VectorStore_FileCounts) GetInProgress() int64 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *VectorStore_FileCounts) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *VectorStore_FileCounts) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *VectorStore_FileCounts) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *VectorStore_FileCounts) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ChunkingStrategy_Static struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxChunkSizeTokens int64 `protobuf:"varint,1,opt,name=max_chunk_size_tokens,json=maxChunkSizeTokens,proto3" json:"max_chunk_size_tokens,omitempty"`
+	ChunkOverlapTokens int64 `protobuf:"varint,2,opt,name=chunk_overlap_tokens,json=chunkOverlapTokens,proto3" json:"chunk_overlap_tokens,omitempty"`
+}
+
+func (x *ChunkingStrategy_Static) Reset() {
+	*x = ChunkingStrategy_Static{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkingStrategy_Static) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkingStrategy_Static) ProtoMessage() {}
 
-func (x *SearchVectorStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[19]
+func (x *ChunkingStrategy_Static) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,19 +2635,215 @@ func (x *SearchVectorStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchVectorStoreResponse.ProtoReflect.Descriptor instead.
-func (*SearchVectorStoreResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ChunkingStrategy_Static.ProtoReflect.Descriptor instead.
+func (*ChunkingStrategy_Static) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *SearchVectorStoreResponse) GetDocuments() []string {
+func (x *ChunkingStrategy_Static) GetMaxChunkSizeTokens() int64 {
 	if x != nil {
-		return x.Documents
+		return x.MaxChunkSizeTokens
 	}
-	return nil
+	return 0
 }
 
-type VectorStore_FileCounts struct {
+func (x *ChunkingStrategy_Static) GetChunkOverlapTokens() int64 {
+	if x != nil {
+		return x.ChunkOverlapTokens
+	}
+	return 0
+}
+
+type ChunkingStrategy_Auto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The method used to split the file. One of headings, pages, sentences, code, or records.
+	Method             string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MaxChunkSizeTokens int64  `protobuf:"varint,2,opt,name=max_chunk_size_tokens,json=maxChunkSizeTokens,proto3" json:"max_chunk_size_tokens,omitempty"`
+	ChunkOverlapTokens int64  `protobuf:"varint,3,opt,name=chunk_overlap_tokens,json=chunkOverlapTokens,proto3" json:"chunk_overlap_tokens,omitempty"`
+}
+
+func (x *ChunkingStrategy_Auto) Reset() {
+	*x = ChunkingStrategy_Auto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkingStrategy_Auto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkingStrategy_Auto) ProtoMessage() {}
+
+func (x *ChunkingStrategy_Auto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkingStrategy_Auto.ProtoReflect.Descriptor instead.
+func (*ChunkingStrategy_Auto) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *ChunkingStrategy_Auto) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ChunkingStrategy_Auto) GetMaxChunkSizeTokens() int64 {
+	if x != nil {
+		return x.MaxChunkSizeTokens
+	}
+	return 0
+}
+
+func (x *ChunkingStrategy_Auto) GetChunkOverlapTokens() int64 {
+	if x != nil {
+		return x.ChunkOverlapTokens
+	}
+	return 0
+}
+
+type ChunkingStrategy_Semantic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The text is split into sentences, and each sentence is embedded together with its neighbors. A new chunk
+	// starts where the embedding distance between adjacent sentences exceeds this percentile of all the
+	// distances. Must be between 1 and 99. Defaults to 95.
+	BreakpointPercentile int32 `protobuf:"varint,1,opt,name=breakpoint_percentile,json=breakpointPercentile,proto3" json:"breakpoint_percentile,omitempty"`
+	// The number of neighboring sentences on each side that are embedded together with a sentence.
+	// Must be between 1 and 5. Defaults to 1.
+	BufferSize int32 `protobuf:"varint,2,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	// Chunks larger than this are split at sentence boundaries. Defaults to 800.
+	MaxChunkSizeTokens int64 `protobuf:"varint,3,opt,name=max_chunk_size_tokens,json=maxChunkSizeTokens,proto3" json:"max_chunk_size_tokens,omitempty"`
+}
+
+func (x *ChunkingStrategy_Semantic) Reset() {
+	*x = ChunkingStrategy_Semantic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkingStrategy_Semantic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkingStrategy_Semantic) ProtoMessage() {}
+
+func (x *ChunkingStrategy_Semantic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkingStrategy_Semantic.ProtoReflect.Descriptor instead.
+func (*ChunkingStrategy_Semantic) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *ChunkingStrategy_Semantic) GetBreakpointPercentile() int32 {
+	if x != nil {
+		return x.BreakpointPercentile
+	}
+	return 0
+}
+
+func (x *ChunkingStrategy_Semantic) GetBufferSize() int32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+func (x *ChunkingStrategy_Semantic) GetMaxChunkSizeTokens() int64 {
+	if x != nil {
+		return x.MaxChunkSizeTokens
+	}
+	return 0
+}
+
+type VectorStoreFile_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of server_error or rate_limit_exceeded.
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *VectorStoreFile_Error) Reset() {
+	*x = VectorStoreFile_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_vector_store_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorStoreFile_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorStoreFile_Error) ProtoMessage() {}
+
+func (x *VectorStoreFile_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorStoreFile_Error.ProtoReflect.Descriptor instead.
+func (*VectorStoreFile_Error) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *VectorStoreFile_Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VectorStoreFile_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VectorStoreFileBatch_FileCounts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1404,23 +2855,23 @@ type VectorStore_FileCounts struct {
 	Total      int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *VectorStore_FileCounts) Reset() {
-	*x = VectorStore_FileCounts{}
+func (x *VectorStoreFileBatch_FileCounts) Reset() {
+	*x = VectorStoreFileBatch_FileCounts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[20]
+		mi := &file_api_v1_vector_store_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VectorStore_FileCounts) String() string {
+func (x *VectorStoreFileBatch_FileCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VectorStore_FileCounts) ProtoMessage() {}
+func (*VectorStoreFileBatch_FileCounts) ProtoMessage() {}
 
-func (x *VectorStore_FileCounts) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[20]
+func (x *VectorStoreFileBatch_FileCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,72 +2882,76 @@ func (x *VectorStore_FileCounts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VectorStore_FileCounts.ProtoReflect.Descriptor instead.
-func (*VectorStore_FileCounts) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use VectorStoreFileBatch_FileCounts.ProtoReflect.Descriptor instead.
+func (*VectorStoreFileBatch_FileCounts) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *VectorStore_FileCounts) GetInProgress() int64 {
+func (x *VectorStoreFileBatch_FileCounts) GetInProgress() int64 {
 	if x != nil {
 		return x.InProgress
 	}
 	return 0
 }
 
-func (x *VectorStore_FileCounts) GetCompleted() int64 {
+func (x *VectorStoreFileBatch_FileCounts) GetCompleted() int64 {
 	if x != nil {
 		return x.Completed
 	}
 	return 0
 }
 
-func (x *VectorStore_FileCounts) GetFailed() int64 {
+func (x *VectorStoreFileBatch_FileCounts) GetFailed() int64 {
 	if x != nil {
 		return x.Failed
 	}
 	return 0
 }
 
-func (x *VectorStore_FileCounts) GetCancelled() int64 {
+func (x *VectorStoreFileBatch_FileCounts) GetCancelled() int64 {
 	if x != nil {
 		return x.Cancelled
 	}
 	return 0
 }
 
-func (x *VectorStore_FileCounts) GetTotal() int64 {
+func (x *VectorStoreFileBatch_FileCounts) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type ChunkingStrategy_Static struct {
+type VectorStoreSearchRequest_RankingOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxChunkSizeTokens int64 `protobuf:"varint,1,opt,name=max_chunk_size_tokens,json=maxChunkSizeTokens,proto3" json:"max_chunk_size_tokens,omitempty"`
-	ChunkOverlapTokens int64 `protobuf:"varint,2,opt,name=chunk_overlap_tokens,json=chunkOverlapTokens,proto3" json:"chunk_overlap_tokens,omitempty"`
+	// One of auto or default-2024-11-15.
+	Ranker string `protobuf:"bytes,1,opt,name=ranker,proto3" json:"ranker,omitempty"`
+	// The minimum score of the results, which must be between 0 and 1.
+	ScoreThreshold float32 `protobuf:"fixed32,2,opt,name=score_threshold,json=scoreThreshold,proto3" json:"score_threshold,omitempty"`
+	// Whether to rerank the results with the configured reranker. The scores of the results are the reranker scores.
+	Rerank bool `protobuf:"varint,3,opt,name=rerank,proto3" json:"rerank,omitempty"`
 }
 
-func (x *ChunkingStrategy_Static) Reset() {
-	*x = ChunkingStrategy_Static{}
+func (x *VectorStoreSearchRequest_RankingOptions) Reset() {
+	*x = VectorStoreSearchRequest_RankingOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[22]
+		mi := &file_api_v1_vector_store_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChunkingStrategy_Static) String() string {
+func (x *VectorStoreSearchRequest_RankingOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChunkingStrategy_Static) ProtoMessage() {}
+func (*VectorStoreSearchRequest_RankingOptions) ProtoMessage() {}
 
-func (x *ChunkingStrategy_Static) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[22]
+func (x *VectorStoreSearchRequest_RankingOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,52 +2962,59 @@ func (x *ChunkingStrategy_Static) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChunkingStrategy_Static.ProtoReflect.Descriptor instead.
-func (*ChunkingStrategy_Static) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use VectorStoreSearchRequest_RankingOptions.ProtoReflect.Descriptor instead.
+func (*VectorStoreSearchRequest_RankingOptions) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{25, 0}
 }
 
-func (x *ChunkingStrategy_Static) GetMaxChunkSizeTokens() int64 {
+func (x *VectorStoreSearchRequest_RankingOptions) GetRanker() string {
 	if x != nil {
-		return x.MaxChunkSizeTokens
+		return x.Ranker
 	}
-	return 0
+	return ""
 }
 
-func (x *ChunkingStrategy_Static) GetChunkOverlapTokens() int64 {
+func (x *VectorStoreSearchRequest_RankingOptions) GetScoreThreshold() float32 {
 	if x != nil {
-		return x.ChunkOverlapTokens
+		return x.ScoreThreshold
 	}
 	return 0
 }
 
-type VectorStoreFile_Error struct {
+func (x *VectorStoreSearchRequest_RankingOptions) GetRerank() bool {
+	if x != nil {
+		return x.Rerank
+	}
+	return false
+}
+
+type VectorStoreSearchResult_Content struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// One of server_error or rate_limit_exceeded.
-	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The type of the content. Currently only text is supported.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *VectorStoreFile_Error) Reset() {
-	*x = VectorStoreFile_Error{}
+func (x *VectorStoreSearchResult_Content) Reset() {
+	*x = VectorStoreSearchResult_Content{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_vector_store_proto_msgTypes[25]
+		mi := &file_api_v1_vector_store_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VectorStoreFile_Error) String() string {
+func (x *VectorStoreSearchResult_Content) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VectorStoreFile_Error) ProtoMessage() {}
+func (*VectorStoreSearchResult_Content) ProtoMessage() {}
 
-func (x *VectorStoreFile_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_vector_store_proto_msgTypes[25]
+func (x *VectorStoreSearchResult_Content) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vector_store_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,21 +3025,21 @@ func (x *VectorStoreFile_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VectorStoreFile_Error.ProtoReflect.Descriptor instead.
-func (*VectorStoreFile_Error) Descriptor() ([]byte, []int) {
-	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use VectorStoreSearchResult_Content.ProtoReflect.Descriptor instead.
+func (*VectorStoreSearchResult_Content) Descriptor() ([]byte, []int) {
+	return file_api_v1_vector_store_proto_rawDescGZIP(), []int{27, 0}
 }
 
-func (x *VectorStoreFile_Error) GetCode() string {
+func (x *VectorStoreSearchResult_Content) GetType() string {
 	if x != nil {
-		return x.Code
+		return x.Type
 	}
 	return ""
 }
 
-func (x *VectorStoreFile_Error) GetMessage() string {
+func (x *VectorStoreSearchResult_Content) GetText() string {
 	if x != nil {
-		return x.Message
+		return x.Text
 	}
 	return ""
 }
@@ -1594,7 +3056,7 @@ var file_api_v1_vector_store_proto_rawDesc = []byte{
 	0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
 	0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
-	0x22, 0xb1, 0x05, 0x0a, 0x0b, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x22, 0xd8, 0x06, 0x0a, 0x0b, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
@@ -1623,139 +3085,208 @@ var file_api_v1_vector_store_proto_rawDesc = []byte{
 	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x97, 0x01,
-	0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x63, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x1a, 0x6d, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
-	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x18, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
-	0x66, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x5d, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
-	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x75, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65, 0x22,
-	0xbd, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22,
-	0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a,
+	0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
 	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6c,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x0b,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x65, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x65, 0x66,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x95, 0x05, 0x0a, 0x10, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x44, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x12, 0x50, 0x0a, 0x08,
+	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x53, 0x65, 0x6d, 0x61,
+	0x6e, 0x74, 0x69, 0x63, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x1a, 0x6d,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x83, 0x01,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x1a, 0x93, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x12, 0x33, 0x0a, 0x15, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0x9b, 0x03, 0x0a, 0x0f, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a,
-	0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a,
-	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x5d, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x0c,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x75, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73,
+	0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73,
+	0x4d, 0x6f, 0x72, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xa8, 0x02, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x5d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xb6, 0x04, 0x0a, 0x0f, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x5a, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x58,
-	0x0a, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0xb9, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xe1, 0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f,
@@ -1766,178 +3297,438 @@ var file_api_v1_vector_store_proto_rawDesc = []byte{
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e,
 	0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x1b,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x67, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x47, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb9, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xc5, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x94, 0x03, 0x0a, 0x14, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
 	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22,
-	0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a,
-	0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x61,
-	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0x7d, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6e,
-	0x75, 0x6d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x39, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xee, 0x0c, 0x0a, 0x12,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0xed, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x6c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x63, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x21, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0xd9, 0x01,
+	0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xdb, 0x03,
+	0x0a, 0x18, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x6b, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x1a, 0x69, 0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xa2, 0x01, 0x0a, 0x0d,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0xb3, 0x02, 0x0a, 0x17, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x31, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
 	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a,
-	0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6c,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0xaf, 0x02, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x8a, 0x01, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
-	0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x78, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0x00, 0x12, 0x93, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x72, 0x61, 0x6e,
+	0x6b, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x44, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x7c, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x32, 0xfb, 0x14, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x32, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x78,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
 	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
-	0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
-	0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x00, 0x12, 0x93, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9e,
+	0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
 	0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x15, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0xb2, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x34,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x2e,
 	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
-	0xba, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x35, 0x12, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x2a, 0x33, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f,
+	0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xc8, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0xca, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x39, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0xb3, 0x01, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
 	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f,
-	0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
-	0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x2e, 0x6c,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f,
+	0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xd7, 0x01, 0x0a, 0x1a, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22,
+	0x42, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x2f, 0x7b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0xe0, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x49, 0x6e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x3d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x2a, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x32, 0x9f, 0x01, 0x0a,
-	0x1a, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x11,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a,
+	0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x32, 0x9f,
+	0x01, 0x0a, 0x1a, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x80, 0x01,
+	0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
-	0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32,
-	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6c, 0x6d,
-	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1952,77 +3743,125 @@ func file_api_v1_vector_store_proto_rawDescGZIP() []byte {
 	return file_api_v1_vector_store_proto_rawDescData
 }
 
-var file_api_v1_vector_store_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_v1_vector_store_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_api_v1_vector_store_proto_goTypes = []interface{}{
-	(*ExpiresAfter)(nil),                  // 0: llmariner.vector_store.v1.ExpiresAfter
-	(*VectorStore)(nil),                   // 1: llmariner.vector_store.v1.VectorStore
-	(*ChunkingStrategy)(nil),              // 2: llmariner.vector_store.v1.ChunkingStrategy
-	(*CreateVectorStoreRequest)(nil),      // 3: llmariner.vector_store.v1.CreateVectorStoreRequest
-	(*ListVectorStoresRequest)(nil),       // 4: llmariner.vector_store.v1.ListVectorStoresRequest
-	(*ListVectorStoresResponse)(nil),      // 5: llmariner.vector_store.v1.ListVectorStoresResponse
-	(*GetVectorStoreRequest)(nil),         // 6: llmariner.vector_store.v1.GetVectorStoreRequest
-	(*GetVectorStoreByNameRequest)(nil),   // 7: llmariner.vector_store.v1.GetVectorStoreByNameRequest
-	(*UpdateVectorStoreRequest)(nil),      // 8: llmariner.vector_store.v1.UpdateVectorStoreRequest
-	(*DeleteVectorStoreRequest)(nil),      // 9: llmariner.vector_store.v1.DeleteVectorStoreRequest
-	(*DeleteVectorStoreResponse)(nil),     // 10: llmariner.vector_store.v1.DeleteVectorStoreResponse
-	(*VectorStoreFile)(nil),               // 11: llmariner.vector_store.v1.VectorStoreFile
-	(*CreateVectorStoreFileRequest)(nil),  // 12: llmariner.vector_store.v1.CreateVectorStoreFileRequest
-	(*ListVectorStoreFilesRequest)(nil),   // 13: llmariner.vector_store.v1.ListVectorStoreFilesRequest
-	(*ListVectorStoreFilesResponse)(nil),  // 14: llmariner.vector_store.v1.ListVectorStoreFilesResponse
-	(*GetVectorStoreFileRequest)(nil),     // 15: llmariner.vector_store.v1.GetVectorStoreFileRequest
-	(*DeleteVectorStoreFileRequest)(nil),  // 16: llmariner.vector_store.v1.DeleteVectorStoreFileRequest
-	(*DeleteVectorStoreFileResponse)(nil), // 17: llmariner.vector_store.v1.DeleteVectorStoreFileResponse
-	(*SearchVectorStoreRequest)(nil),      // 18: llmariner.vector_store.v1.SearchVectorStoreRequest
-	(*SearchVectorStoreResponse)(nil),     // 19: llmariner.vector_store.v1.SearchVectorStoreResponse
-	(*VectorStore_FileCounts)(nil),        // 20: llmariner.vector_store.v1.VectorStore.FileCounts
-	nil,                                   // 21: llmariner.vector_store.v1.VectorStore.MetadataEntry
-	(*ChunkingStrategy_Static)(nil),       // 22: llmariner.vector_store.v1.ChunkingStrategy.Static
-	nil,                                   // 23: llmariner.vector_store.v1.CreateVectorStoreRequest.MetadataEntry
-	nil,                                   // 24: llmariner.vector_store.v1.UpdateVectorStoreRequest.MetadataEntry
-	(*VectorStoreFile_Error)(nil),         // 25: llmariner.vector_store.v1.VectorStoreFile.Error
+	(*ExpiresAfter)(nil),                            // 0: llmariner.vector_store.v1.ExpiresAfter
+	(*VectorStore)(nil),                             // 1: llmariner.vector_store.v1.VectorStore
+	(*IndexConfig)(nil),                             // 2: llmariner.vector_store.v1.IndexConfig
+	(*ChunkingStrategy)(nil),                        // 3: llmariner.vector_store.v1.ChunkingStrategy
+	(*CreateVectorStoreRequest)(nil),                // 4: llmariner.vector_store.v1.CreateVectorStoreRequest
+	(*ListVectorStoresRequest)(nil),                 // 5: llmariner.vector_store.v1.ListVectorStoresRequest
+	(*ListVectorStoresResponse)(nil),                // 6: llmariner.vector_store.v1.ListVectorStoresResponse
+	(*GetVectorStoreRequest)(nil),                   // 7: llmariner.vector_store.v1.GetVectorStoreRequest
+	(*GetVectorStoreByNameRequest)(nil),             // 8: llmariner.vector_store.v1.GetVectorStoreByNameRequest
+	(*UpdateVectorStoreRequest)(nil),                // 9: llmariner.vector_store.v1.UpdateVectorStoreRequest
+	(*DeleteVectorStoreRequest)(nil),                // 10: llmariner.vector_store.v1.DeleteVectorStoreRequest
+	(*DeleteVectorStoreResponse)(nil),               // 11: llmariner.vector_store.v1.DeleteVectorStoreResponse
+	(*VectorStoreFile)(nil),                         // 12: llmariner.vector_store.v1.VectorStoreFile
+	(*CreateVectorStoreFileRequest)(nil),            // 13: llmariner.vector_store.v1.CreateVectorStoreFileRequest
+	(*ListVectorStoreFilesRequest)(nil),             // 14: llmariner.vector_store.v1.ListVectorStoreFilesRequest
+	(*ListVectorStoreFilesResponse)(nil),            // 15: llmariner.vector_store.v1.ListVectorStoreFilesResponse
+	(*GetVectorStoreFileRequest)(nil),               // 16: llmariner.vector_store.v1.GetVectorStoreFileRequest
+	(*DeleteVectorStoreFileRequest)(nil),            // 17: llmariner.vector_store.v1.DeleteVectorStoreFileRequest
+	(*DeleteVectorStoreFileResponse)(nil),           // 18: llmariner.vector_store.v1.DeleteVectorStoreFileResponse
+	(*VectorStoreFileBatch)(nil),                    // 19: llmariner.vector_store.v1.VectorStoreFileBatch
+	(*CreateVectorStoreFileBatchRequest)(nil),       // 20: llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest
+	(*GetVectorStoreFileBatchRequest)(nil),          // 21: llmariner.vector_store.v1.GetVectorStoreFileBatchRequest
+	(*CancelVectorStoreFileBatchRequest)(nil),       // 22: llmariner.vector_store.v1.CancelVectorStoreFileBatchRequest
+	(*ListFilesInVectorStoreBatchRequest)(nil),      // 23: llmariner.vector_store.v1.ListFilesInVectorStoreBatchRequest
+	(*Filter)(nil),                                  // 24: llmariner.vector_store.v1.Filter
+	(*VectorStoreSearchRequest)(nil),                // 25: llmariner.vector_store.v1.VectorStoreSearchRequest
+	(*ChunkMetadata)(nil),                           // 26: llmariner.vector_store.v1.ChunkMetadata
+	(*VectorStoreSearchResult)(nil),                 // 27: llmariner.vector_store.v1.VectorStoreSearchResult
+	(*VectorStoreSearchResponse)(nil),               // 28: llmariner.vector_store.v1.VectorStoreSearchResponse
+	(*SearchVectorStoreRequest)(nil),                // 29: llmariner.vector_store.v1.SearchVectorStoreRequest
+	(*SearchResult)(nil),                            // 30: llmariner.vector_store.v1.SearchResult
+	(*SearchVectorStoreResponse)(nil),               // 31: llmariner.vector_store.v1.SearchVectorStoreResponse
+	(*VectorStore_FileCounts)(nil),                  // 32: llmariner.vector_store.v1.VectorStore.FileCounts
+	nil,                                             // 33: llmariner.vector_store.v1.VectorStore.MetadataEntry
+	(*ChunkingStrategy_Static)(nil),                 // 34: llmariner.vector_store.v1.ChunkingStrategy.Static
+	(*ChunkingStrategy_Auto)(nil),                   // 35: llmariner.vector_store.v1.ChunkingStrategy.Auto
+	(*ChunkingStrategy_Semantic)(nil),               // 36: llmariner.vector_store.v1.ChunkingStrategy.Semantic
+	nil,                                             // 37: llmariner.vector_store.v1.CreateVectorStoreRequest.MetadataEntry
+	nil,                                             // 38: llmariner.vector_store.v1.UpdateVectorStoreRequest.MetadataEntry
+	(*VectorStoreFile_Error)(nil),                   // 39: llmariner.vector_store.v1.VectorStoreFile.Error
+	nil,                                             // 40: llmariner.vector_store.v1.VectorStoreFile.AttributesEntry
+	nil,                                             // 41: llmariner.vector_store.v1.CreateVectorStoreFileRequest.AttributesEntry
+	(*VectorStoreFileBatch_FileCounts)(nil),         // 42: llmariner.vector_store.v1.VectorStoreFileBatch.FileCounts
+	nil,                                             // 43: llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest.AttributesEntry
+	(*VectorStoreSearchRequest_RankingOptions)(nil), // 44: llmariner.vector_store.v1.VectorStoreSearchRequest.RankingOptions
+	(*VectorStoreSearchResult_Content)(nil),         // 45: llmariner.vector_store.v1.VectorStoreSearchResult.Content
 }
 var file_api_v1_vector_store_proto_depIdxs = []int32{
-	20, // 0: llmariner.vector_store.v1.VectorStore.file_counts:type_name -> llmariner.vector_store.v1.VectorStore.FileCounts
+	32, // 0: llmariner.vector_store.v1.VectorStore.file_counts:type_name -> llmariner.vector_store.v1.VectorStore.FileCounts
 	0,  // 1: llmariner.vector_store.v1.VectorStore.expires_after:type_name -> llmariner.vector_store.v1.ExpiresAfter
-	21, // 2: llmariner.vector_store.v1.VectorStore.metadata:type_name -> llmariner.vector_store.v1.VectorStore.MetadataEntry
-	22, // 3: llmariner.vector_store.v1.ChunkingStrategy.static:type_name -> llmariner.vector_store.v1.ChunkingStrategy.Static
-	0,  // 4: llmariner.vector_store.v1.CreateVectorStoreRequest.expires_after:type_name -> llmariner.vector_store.v1.ExpiresAfter
-	2,  // 5: llmariner.vector_store.v1.CreateVectorStoreRequest.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
-	23, // 6: llmariner.vector_store.v1.CreateVectorStoreRequest.metadata:type_name -> llmariner.vector_store.v1.CreateVectorStoreRequest.MetadataEntry
-	1,  // 7: llmariner.vector_store.v1.ListVectorStoresResponse.data:type_name -> llmariner.vector_store.v1.VectorStore
-	0,  // 8: llmariner.vector_store.v1.UpdateVectorStoreRequest.expires_after:type_name -> llmariner.vector_store.v1.ExpiresAfter
-	24, // 9: llmariner.vector_store.v1.UpdateVectorStoreRequest.metadata:type_name -> llmariner.vector_store.v1.UpdateVectorStoreRequest.MetadataEntry
-	25, // 10: llmariner.vector_store.v1.VectorStoreFile.last_error:type_name -> llmariner.vector_store.v1.VectorStoreFile.Error
-	2,  // 11: llmariner.vector_store.v1.VectorStoreFile.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
-	2,  // 12: llmariner.vector_store.v1.CreateVectorStoreFileRequest.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
-	11, // 13: llmariner.vector_store.v1.ListVectorStoreFilesResponse.data:type_name -> llmariner.vector_store.v1.VectorStoreFile
-	3,  // 14: llmariner.vector_store.v1.VectorStoreService.CreateVectorStore:input_type -> llmariner.vector_store.v1.CreateVectorStoreRequest
-	4,  // 15: llmariner.vector_store.v1.VectorStoreService.ListVectorStores:input_type -> llmariner.vector_store.v1.ListVectorStoresRequest
-	6,  // 16: llmariner.vector_store.v1.VectorStoreService.GetVectorStore:input_type -> llmariner.vector_store.v1.GetVectorStoreRequest
-	7,  // 17: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreByName:input_type -> llmariner.vector_store.v1.GetVectorStoreByNameRequest
-	8,  // 18: llmariner.vector_store.v1.VectorStoreService.UpdateVectorStore:input_type -> llmariner.vector_store.v1.UpdateVectorStoreRequest
-	9,  // 19: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStore:input_type -> llmariner.vector_store.v1.DeleteVectorStoreRequest
-	12, // 20: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFile:input_type -> llmariner.vector_store.v1.CreateVectorStoreFileRequest
-	13, // 21: llmariner.vector_store.v1.VectorStoreService.ListVectorStoreFiles:input_type -> llmariner.vector_store.v1.ListVectorStoreFilesRequest
-	15, // 22: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFile:input_type -> llmariner.vector_store.v1.GetVectorStoreFileRequest
-	16, // 23: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStoreFile:input_type -> llmariner.vector_store.v1.DeleteVectorStoreFileRequest
-	18, // 24: llmariner.vector_store.v1.VectorStoreInternalService.SearchVectorStore:input_type -> llmariner.vector_store.v1.SearchVectorStoreRequest
-	1,  // 25: llmariner.vector_store.v1.VectorStoreService.CreateVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
-	5,  // 26: llmariner.vector_store.v1.VectorStoreService.ListVectorStores:output_type -> llmariner.vector_store.v1.ListVectorStoresResponse
-	1,  // 27: llmariner.vector_store.v1.VectorStoreService.GetVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
-	1,  // 28: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreByName:output_type -> llmariner.vector_store.v1.VectorStore
-	1,  // 29: llmariner.vector_store.v1.VectorStoreService.UpdateVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
-	10, // 30: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStore:output_type -> llmariner.vector_store.v1.DeleteVectorStoreResponse
-	11, // 31: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFile:output_type -> llmariner.vector_store.v1.VectorStoreFile
-	14, // 32: llmariner.vector_store.v1.VectorStoreService.ListVectorStoreFiles:output_type -> llmariner.vector_store.v1.ListVectorStoreFilesResponse
-	11, // 33: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFile:output_type -> llmariner.vector_store.v1.VectorStoreFile
-	17, // 34: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStoreFile:output_type -> llmariner.vector_store.v1.DeleteVectorStoreFileResponse
-	19, // 35: llmariner.vector_store.v1.VectorStoreInternalService.SearchVectorStore:output_type -> llmariner.vector_store.v1.SearchVectorStoreResponse
-	25, // [25:36] is the sub-list for method output_type
-	14, // [14:25] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	33, // 2: llmariner.vector_store.v1.VectorStore.metadata:type_name -> llmariner.vector_store.v1.VectorStore.MetadataEntry
+	2,  // 3: llmariner.vector_store.v1.VectorStore.index_config:type_name -> llmariner.vector_store.v1.IndexConfig
+	34, // 4: llmariner.vector_store.v1.ChunkingStrategy.static:type_name -> llmariner.vector_store.v1.ChunkingStrategy.Static
+	35, // 5: llmariner.vector_store.v1.ChunkingStrategy.auto:type_name -> llmariner.vector_store.v1.ChunkingStrategy.Auto
+	36, // 6: llmariner.vector_store.v1.ChunkingStrategy.semantic:type_name -> llmariner.vector_store.v1.ChunkingStrategy.Semantic
+	0,  // 7: llmariner.vector_store.v1.CreateVectorStoreRequest.expires_after:type_name -> llmariner.vector_store.v1.ExpiresAfter
+	3,  // 8: llmariner.vector_store.v1.CreateVectorStoreRequest.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
+	37, // 9: llmariner.vector_store.v1.CreateVectorStoreRequest.metadata:type_name -> llmariner.vector_store.v1.CreateVectorStoreRequest.MetadataEntry
+	2,  // 10: llmariner.vector_store.v1.CreateVectorStoreRequest.index_config:type_name -> llmariner.vector_store.v1.IndexConfig
+	1,  // 11: llmariner.vector_store.v1.ListVectorStoresResponse.data:type_name -> llmariner.vector_store.v1.VectorStore
+	0,  // 12: llmariner.vector_store.v1.UpdateVectorStoreRequest.expires_after:type_name -> llmariner.vector_store.v1.ExpiresAfter
+	38, // 13: llmariner.vector_store.v1.UpdateVectorStoreRequest.metadata:type_name -> llmariner.vector_store.v1.UpdateVectorStoreRequest.MetadataEntry
+	39, // 14: llmariner.vector_store.v1.VectorStoreFile.last_error:type_name -> llmariner.vector_store.v1.VectorStoreFile.Error
+	3,  // 15: llmariner.vector_store.v1.VectorStoreFile.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
+	40, // 16: llmariner.vector_store.v1.VectorStoreFile.attributes:type_name -> llmariner.vector_store.v1.VectorStoreFile.AttributesEntry
+	3,  // 17: llmariner.vector_store.v1.CreateVectorStoreFileRequest.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
+	41, // 18: llmariner.vector_store.v1.CreateVectorStoreFileRequest.attributes:type_name -> llmariner.vector_store.v1.CreateVectorStoreFileRequest.AttributesEntry
+	12, // 19: llmariner.vector_store.v1.ListVectorStoreFilesResponse.data:type_name -> llmariner.vector_store.v1.VectorStoreFile
+	42, // 20: llmariner.vector_store.v1.VectorStoreFileBatch.file_counts:type_name -> llmariner.vector_store.v1.VectorStoreFileBatch.FileCounts
+	3,  // 21: llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest.chunking_strategy:type_name -> llmariner.vector_store.v1.ChunkingStrategy
+	43, // 22: llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest.attributes:type_name -> llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest.AttributesEntry
+	24, // 23: llmariner.vector_store.v1.Filter.filters:type_name -> llmariner.vector_store.v1.Filter
+	44, // 24: llmariner.vector_store.v1.VectorStoreSearchRequest.ranking_options:type_name -> llmariner.vector_store.v1.VectorStoreSearchRequest.RankingOptions
+	24, // 25: llmariner.vector_store.v1.VectorStoreSearchRequest.filters:type_name -> llmariner.vector_store.v1.Filter
+	45, // 26: llmariner.vector_store.v1.VectorStoreSearchResult.content:type_name -> llmariner.vector_store.v1.VectorStoreSearchResult.Content
+	26, // 27: llmariner.vector_store.v1.VectorStoreSearchResult.metadata:type_name -> llmariner.vector_store.v1.ChunkMetadata
+	27, // 28: llmariner.vector_store.v1.VectorStoreSearchResponse.data:type_name -> llmariner.vector_store.v1.VectorStoreSearchResult
+	24, // 29: llmariner.vector_store.v1.SearchVectorStoreRequest.filters:type_name -> llmariner.vector_store.v1.Filter
+	26, // 30: llmariner.vector_store.v1.SearchResult.metadata:type_name -> llmariner.vector_store.v1.ChunkMetadata
+	30, // 31: llmariner.vector_store.v1.SearchVectorStoreResponse.results:type_name -> llmariner.vector_store.v1.SearchResult
+	4,  // 32: llmariner.vector_store.v1.VectorStoreService.CreateVectorStore:input_type -> llmariner.vector_store.v1.CreateVectorStoreRequest
+	5,  // 33: llmariner.vector_store.v1.VectorStoreService.ListVectorStores:input_type -> llmariner.vector_store.v1.ListVectorStoresRequest
+	7,  // 34: llmariner.vector_store.v1.VectorStoreService.GetVectorStore:input_type -> llmariner.vector_store.v1.GetVectorStoreRequest
+	8,  // 35: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreByName:input_type -> llmariner.vector_store.v1.GetVectorStoreByNameRequest
+	9,  // 36: llmariner.vector_store.v1.VectorStoreService.UpdateVectorStore:input_type -> llmariner.vector_store.v1.UpdateVectorStoreRequest
+	10, // 37: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStore:input_type -> llmariner.vector_store.v1.DeleteVectorStoreRequest
+	13, // 38: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFile:input_type -> llmariner.vector_store.v1.CreateVectorStoreFileRequest
+	14, // 39: llmariner.vector_store.v1.VectorStoreService.ListVectorStoreFiles:input_type -> llmariner.vector_store.v1.ListVectorStoreFilesRequest
+	16, // 40: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFile:input_type -> llmariner.vector_store.v1.GetVectorStoreFileRequest
+	17, // 41: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStoreFile:input_type -> llmariner.vector_store.v1.DeleteVectorStoreFileRequest
+	20, // 42: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFileBatch:input_type -> llmariner.vector_store.v1.CreateVectorStoreFileBatchRequest
+	21, // 43: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFileBatch:input_type -> llmariner.vector_store.v1.GetVectorStoreFileBatchRequest
+	22, // 44: llmariner.vector_store.v1.VectorStoreService.CancelVectorStoreFileBatch:input_type -> llmariner.vector_store.v1.CancelVectorStoreFileBatchRequest
+	23, // 45: llmariner.vector_store.v1.VectorStoreService.ListFilesInVectorStoreBatch:input_type -> llmariner.vector_store.v1.ListFilesInVectorStoreBatchRequest
+	25, // 46: llmariner.vector_store.v1.VectorStoreService.SearchVectorStore:input_type -> llmariner.vector_store.v1.VectorStoreSearchRequest
+	29, // 47: llmariner.vector_store.v1.VectorStoreInternalService.SearchVectorStore:input_type -> llmariner.vector_store.v1.SearchVectorStoreRequest
+	1,  // 48: llmariner.vector_store.v1.VectorStoreService.CreateVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
+	6,  // 49: llmariner.vector_store.v1.VectorStoreService.ListVectorStores:output_type -> llmariner.vector_store.v1.ListVectorStoresResponse
+	1,  // 50: llmariner.vector_store.v1.VectorStoreService.GetVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
+	1,  // 51: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreByName:output_type -> llmariner.vector_store.v1.VectorStore
+	1,  // 52: llmariner.vector_store.v1.VectorStoreService.UpdateVectorStore:output_type -> llmariner.vector_store.v1.VectorStore
+	11, // 53: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStore:output_type -> llmariner.vector_store.v1.DeleteVectorStoreResponse
+	12, // 54: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFile:output_type -> llmariner.vector_store.v1.VectorStoreFile
+	15, // 55: llmariner.vector_store.v1.VectorStoreService.ListVectorStoreFiles:output_type -> llmariner.vector_store.v1.ListVectorStoreFilesResponse
+	12, // 56: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFile:output_type -> llmariner.vector_store.v1.VectorStoreFile
+	18, // 57: llmariner.vector_store.v1.VectorStoreService.DeleteVectorStoreFile:output_type -> llmariner.vector_store.v1.DeleteVectorStoreFileResponse
+	19, // 58: llmariner.vector_store.v1.VectorStoreService.CreateVectorStoreFileBatch:output_type -> llmariner.vector_store.v1.VectorStoreFileBatch
+	19, // 59: llmariner.vector_store.v1.VectorStoreService.GetVectorStoreFileBatch:output_type -> llmariner.vector_store.v1.VectorStoreFileBatch
+	19, // 60: llmariner.vector_store.v1.VectorStoreService.CancelVectorStoreFileBatch:output_type -> llmariner.vector_store.v1.VectorStoreFileBatch
+	15, // 61: llmariner.vector_store.v1.VectorStoreService.ListFilesInVectorStoreBatch:output_type -> llmariner.vector_store.v1.ListVectorStoreFilesResponse
+	28, // 62: llmariner.vector_store.v1.VectorStoreService.SearchVectorStore:output_type -> llmariner.vector_store.v1.VectorStoreSearchResponse
+	31, // 63: llmariner.vector_store.v1.VectorStoreInternalService.SearchVectorStore:output_type -> llmariner.vector_store.v1.SearchVectorStoreResponse
+	48, // [48:64] is the sub-list for method output_type
+	32, // [32:48] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_vector_store_proto_init() }
@@ -2056,7 +3895,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChunkingStrategy); i {
+			switch v := v.(*IndexConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2068,7 +3907,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVectorStoreRequest); i {
+			switch v := v.(*ChunkingStrategy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2080,7 +3919,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVectorStoresRequest); i {
+			switch v := v.(*CreateVectorStoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2092,7 +3931,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVectorStoresResponse); i {
+			switch v := v.(*ListVectorStoresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2104,7 +3943,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorStoreRequest); i {
+			switch v := v.(*ListVectorStoresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2116,7 +3955,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorStoreByNameRequest); i {
+			switch v := v.(*GetVectorStoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2128,7 +3967,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVectorStoreRequest); i {
+			switch v := v.(*GetVectorStoreByNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2140,7 +3979,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVectorStoreRequest); i {
+			switch v := v.(*UpdateVectorStoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,7 +3991,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVectorStoreResponse); i {
+			switch v := v.(*DeleteVectorStoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2164,7 +4003,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorStoreFile); i {
+			switch v := v.(*DeleteVectorStoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2176,7 +4015,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVectorStoreFileRequest); i {
+			switch v := v.(*VectorStoreFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2188,7 +4027,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVectorStoreFilesRequest); i {
+			switch v := v.(*CreateVectorStoreFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,7 +4039,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVectorStoreFilesResponse); i {
+			switch v := v.(*ListVectorStoreFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,7 +4051,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorStoreFileRequest); i {
+			switch v := v.(*ListVectorStoreFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,7 +4063,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVectorStoreFileRequest); i {
+			switch v := v.(*GetVectorStoreFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,7 +4075,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVectorStoreFileResponse); i {
+			switch v := v.(*DeleteVectorStoreFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,7 +4087,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchVectorStoreRequest); i {
+			switch v := v.(*DeleteVectorStoreFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,7 +4099,7 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchVectorStoreResponse); i {
+			switch v := v.(*VectorStoreFileBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,7 +4111,19 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorStore_FileCounts); i {
+			switch v := v.(*CreateVectorStoreFileBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVectorStoreFileBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2284,7 +4135,31 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChunkingStrategy_Static); i {
+			switch v := v.(*CancelVectorStoreFileBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesInVectorStoreBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2296,6 +4171,138 @@ func file_api_v1_vector_store_proto_init() {
 			}
 		}
 		file_api_v1_vector_store_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVectorStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVectorStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStore_FileCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkingStrategy_Static); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkingStrategy_Auto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkingStrategy_Semantic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VectorStoreFile_Error); i {
 			case 0:
 				return &v.state
@@ -2307,6 +4314,42 @@ func file_api_v1_vector_store_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_vector_store_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreFileBatch_FileCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreSearchRequest_RankingOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_vector_store_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorStoreSearchResult_Content); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2314,7 +4357,7 @@ func file_api_v1_vector_store_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_vector_store_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -28,6 +28,11 @@ type VectorStoreServiceClient interface {
 	ListVectorStoreFiles(ctx context.Context, in *ListVectorStoreFilesRequest, opts ...grpc.CallOption) (*ListVectorStoreFilesResponse, error)
 	GetVectorStoreFile(ctx context.Context, in *GetVectorStoreFileRequest, opts ...grpc.CallOption) (*VectorStoreFile, error)
 	DeleteVectorStoreFile(ctx context.Context, in *DeleteVectorStoreFileRequest, opts ...grpc.CallOption) (*DeleteVectorStoreFileResponse, error)
+	CreateVectorStoreFileBatch(ctx context.Context, in *CreateVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error)
+	GetVectorStoreFileBatch(ctx context.Context, in *GetVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error)
+	CancelVectorStoreFileBatch(ctx context.Context, in *CancelVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error)
+	ListFilesInVectorStoreBatch(ctx context.Context, in *ListFilesInVectorStoreBatchRequest, opts ...grpc.CallOption) (*ListVectorStoreFilesResponse, error)
+	SearchVectorStore(ctx context.Context, in *VectorStoreSearchRequest, opts ...grpc.CallOption) (*VectorStoreSearchResponse, error)
 }
 
 type vectorStoreServiceClient struct {
@@ -128,6 +133,51 @@ func (c *vectorStoreServiceClient) DeleteVectorStoreFile(ctx context.Context, in
 	return out, nil
 }
 
+func (c *vectorStoreServiceClient) CreateVectorStoreFileBatch(ctx context.Context, in *CreateVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error) {
+	out := new(VectorStoreFileBatch)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/CreateVectorStoreFileBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorStoreServiceClient) GetVectorStoreFileBatch(ctx context.Context, in *GetVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error) {
+	out := new(VectorStoreFileBatch)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/GetVectorStoreFileBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorStoreServiceClient) CancelVectorStoreFileBatch(ctx context.Context, in *CancelVectorStoreFileBatchRequest, opts ...grpc.CallOption) (*VectorStoreFileBatch, error) {
+	out := new(VectorStoreFileBatch)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/CancelVectorStoreFileBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorStoreServiceClient) ListFilesInVectorStoreBatch(ctx context.Context, in *ListFilesInVectorStoreBatchRequest, opts ...grpc.CallOption) (*ListVectorStoreFilesResponse, error) {
+	out := new(ListVectorStoreFilesResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/ListFilesInVectorStoreBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorStoreServiceClient) SearchVectorStore(ctx context.Context, in *VectorStoreSearchRequest, opts ...grpc.CallOption) (*VectorStoreSearchResponse, error) {
+	out := new(VectorStoreSearchResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/SearchVectorStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VectorStoreServiceServer is the server API for VectorStoreService service.
 // All implementations must embed UnimplementedVectorStoreServiceServer
 // for forward compatibility
@@ -142,6 +192,11 @@ type VectorStoreServiceServer interface {
 	ListVectorStoreFiles(context.Context, *ListVectorStoreFilesRequest) (*ListVectorStoreFilesResponse, error)
 	GetVectorStoreFile(context.Context, *GetVectorStoreFileRequest) (*VectorStoreFile, error)
 	DeleteVectorStoreFile(context.Context, *DeleteVectorStoreFileRequest) (*DeleteVectorStoreFileResponse, error)
+	CreateVectorStoreFileBatch(context.Context, *CreateVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error)
+	GetVectorStoreFileBatch(context.Context, *GetVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error)
+	CancelVectorStoreFileBatch(context.Context, *CancelVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error)
+	ListFilesInVectorStoreBatch(context.Context, *ListFilesInVectorStoreBatchRequest) (*ListVectorStoreFilesResponse, error)
+	SearchVectorStore(context.Context, *VectorStoreSearchRequest) (*VectorStoreSearchResponse, error)
 	mustEmbedUnimplementedVectorStoreServiceServer()
 }
 
@@ -179,6 +234,21 @@ func (UnimplementedVectorStoreServiceServer) GetVectorStoreFile(context.Context,
 func (UnimplementedVectorStoreServiceServer) DeleteVectorStoreFile(context.Context, *DeleteVectorStoreFileRequest) (*DeleteVectorStoreFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVectorStoreFile not implemented")
 }
+func (UnimplementedVectorStoreServiceServer) CreateVectorStoreFileBatch(context.Context, *CreateVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVectorStoreFileBatch not implemented")
+}
+func (UnimplementedVectorStoreServiceServer) GetVectorStoreFileBatch(context.Context, *GetVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVectorStoreFileBatch not implemented")
+}
+func (UnimplementedVectorStoreServiceServer) CancelVectorStoreFileBatch(context.Context, *CancelVectorStoreFileBatchRequest) (*VectorStoreFileBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVectorStoreFileBatch not implemented")
+}
+func (UnimplementedVectorStoreServiceServer) ListFilesInVectorStoreBatch(context.Context, *ListFilesInVectorStoreBatchRequest) (*ListVectorStoreFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilesInVectorStoreBatch not implemented")
+}
+func (UnimplementedVectorStoreServiceServer) SearchVectorStore(context.Context, *VectorStoreSearchRequest) (*VectorStoreSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVectorStore not implemented")
+}
 func (UnimplementedVectorStoreServiceServer) mustEmbedUnimplementedVectorStoreServiceServer() {}
 
 // UnsafeVectorStoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -372,6 +442,96 @@ func _VectorStoreService_DeleteVectorStoreFile_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VectorStoreService_CreateVectorStoreFileBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVectorStoreFileBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).CreateVectorStoreFileBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/CreateVectorStoreFileBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).CreateVectorStoreFileBatch(ctx, req.(*CreateVectorStoreFileBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorStoreService_GetVectorStoreFileBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVectorStoreFileBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).GetVectorStoreFileBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/GetVectorStoreFileBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).GetVectorStoreFileBatch(ctx, req.(*GetVectorStoreFileBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorStoreService_CancelVectorStoreFileBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVectorStoreFileBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).CancelVectorStoreFileBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/CancelVectorStoreFileBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).CancelVectorStoreFileBatch(ctx, req.(*CancelVectorStoreFileBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorStoreService_ListFilesInVectorStoreBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesInVectorStoreBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).ListFilesInVectorStoreBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/ListFilesInVectorStoreBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).ListFilesInVectorStoreBatch(ctx, req.(*ListFilesInVectorStoreBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorStoreService_SearchVectorStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorStoreSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).SearchVectorStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/SearchVectorStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).SearchVectorStore(ctx, req.(*VectorStoreSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VectorStoreService_ServiceDesc is the grpc.ServiceDesc for VectorStoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +579,26 @@ var VectorStoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVectorStoreFile",
 			Handler:    _VectorStoreService_DeleteVectorStoreFile_Handler,
 		},
+		{
+			MethodName: "CreateVectorStoreFileBatch",
+			Handler:    _VectorStoreService_CreateVectorStoreFileBatch_Handler,
+		},
+		{
+			MethodName: "GetVectorStoreFileBatch",
+			Handler:    _VectorStoreService_GetVectorStoreFileBatch_Handler,
+		},
+		{
+			MethodName: "CancelVectorStoreFileBatch",
+			Handler:    _VectorStoreService_CancelVectorStoreFileBatch_Handler,
+		},
+		{
+			MethodName: "ListFilesInVectorStoreBatch",
+			Handler:    _VectorStoreService_ListFilesInVectorStoreBatch_Handler,
+		},
+		{
+			MethodName: "SearchVectorStore",
+			Handler:    _VectorStoreService_SearchVectorStore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/vector_store.proto",
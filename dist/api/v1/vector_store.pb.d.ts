@@ -24,14 +24,39 @@ export type VectorStore = {
     metadata?: {
         [key: string]: string;
     };
+    indexConfig?: IndexConfig;
+    embeddingModel?: string;
+    embeddingDimensions?: number;
+};
+export type IndexConfig = {
+    metricType?: string;
+    indexType?: string;
+    nlist?: number;
+    nprobe?: number;
+    m?: number;
+    efConstruction?: number;
+    ef?: number;
+    searchList?: number;
 };
 export type ChunkingStrategyStatic = {
     maxChunkSizeTokens?: string;
     chunkOverlapTokens?: string;
 };
+export type ChunkingStrategyAuto = {
+    method?: string;
+    maxChunkSizeTokens?: string;
+    chunkOverlapTokens?: string;
+};
+export type ChunkingStrategySemantic = {
+    breakpointPercentile?: number;
+    bufferSize?: number;
+    maxChunkSizeTokens?: string;
+};
 export type ChunkingStrategy = {
     type?: string;
     static?: ChunkingStrategyStatic;
+    auto?: ChunkingStrategyAuto;
+    semantic?: ChunkingStrategySemantic;
 };
 export type CreateVectorStoreRequest = {
     fileIds?: string[];
@@ -41,6 +66,8 @@ export type CreateVectorStoreRequest = {
     metadata?: {
         [key: string]: string;
     };
+    indexConfig?: IndexConfig;
+    embeddingModel?: string;
 };
 export type ListVectorStoresRequest = {
     limit?: number;
@@ -90,11 +117,17 @@ export type VectorStoreFile = {
     status?: string;
     lastError?: VectorStoreFileError;
     chunkingStrategy?: ChunkingStrategy;
+    attributes?: {
+        [key: string]: string;
+    };
 };
 export type CreateVectorStoreFileRequest = {
     vectorStoreId?: string;
     fileId?: string;
     chunkingStrategy?: ChunkingStrategy;
+    attributes?: {
+        [key: string]: string;
+    };
 };
 export type ListVectorStoreFilesRequest = {
     vectorStoreId?: string;
@@ -124,13 +157,112 @@ export type DeleteVectorStoreFileResponse = {
     object?: string;
     deleted?: boolean;
 };
+export type VectorStoreFileBatchFileCounts = {
+    inProgress?: string;
+    completed?: string;
+    failed?: string;
+    cancelled?: string;
+    total?: string;
+};
+export type VectorStoreFileBatch = {
+    id?: string;
+    object?: string;
+    createdAt?: string;
+    vectorStoreId?: string;
+    status?: string;
+    fileCounts?: VectorStoreFileBatchFileCounts;
+};
+export type CreateVectorStoreFileBatchRequest = {
+    vectorStoreId?: string;
+    fileIds?: string[];
+    chunkingStrategy?: ChunkingStrategy;
+    attributes?: {
+        [key: string]: string;
+    };
+};
+export type GetVectorStoreFileBatchRequest = {
+    vectorStoreId?: string;
+    batchId?: string;
+};
+export type CancelVectorStoreFileBatchRequest = {
+    vectorStoreId?: string;
+    batchId?: string;
+};
+export type ListFilesInVectorStoreBatchRequest = {
+    vectorStoreId?: string;
+    batchId?: string;
+    limit?: number;
+    order?: string;
+    after?: string;
+    before?: string;
+    filter?: string;
+};
+export type Filter = {
+    type?: string;
+    key?: string;
+    value?: string;
+    filters?: Filter[];
+};
+export type VectorStoreSearchRequestRankingOptions = {
+    ranker?: string;
+    scoreThreshold?: number;
+    rerank?: boolean;
+};
+export type VectorStoreSearchRequest = {
+    vectorStoreId?: string;
+    query?: string;
+    maxNumResults?: number;
+    rankingOptions?: VectorStoreSearchRequestRankingOptions;
+    rewriteQuery?: boolean;
+    filters?: Filter;
+    searchMode?: string;
+};
+export type ChunkMetadata = {
+    page?: number;
+    headings?: string[];
+    chunkIndex?: number;
+    startOffset?: number;
+    endOffset?: number;
+};
+export type VectorStoreSearchResultContent = {
+    type?: string;
+    text?: string;
+};
+export type VectorStoreSearchResult = {
+    fileId?: string;
+    filename?: string;
+    score?: number;
+    content?: VectorStoreSearchResultContent[];
+    metadata?: ChunkMetadata;
+};
+export type VectorStoreSearchResponse = {
+    object?: string;
+    searchQuery?: string;
+    data?: VectorStoreSearchResult[];
+    hasMore?: boolean;
+    nextPage?: string;
+};
 export type SearchVectorStoreRequest = {
     vectorStoreId?: string;
     query?: string;
     numDocuments?: number;
+    projectId?: string;
+    tenantId?: string;
+    filters?: Filter;
+    searchMode?: string;
+    rerank?: boolean;
+};
+export type SearchResult = {
+    chunkId?: string;
+    fileId?: string;
+    filename?: string;
+    score?: number;
+    text?: string;
+    metadata?: ChunkMetadata;
 };
 export type SearchVectorStoreResponse = {
     documents?: string[];
+    results?: SearchResult[];
 };
 export declare class VectorStoreService {
     static CreateVectorStore(req: CreateVectorStoreRequest, initReq?: fm.InitReq): Promise<VectorStore>;
@@ -143,6 +275,11 @@ export declare class VectorStoreService {
     static ListVectorStoreFiles(req: ListVectorStoreFilesRequest, initReq?: fm.InitReq): Promise<ListVectorStoreFilesResponse>;
     static GetVectorStoreFile(req: GetVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile>;
     static DeleteVectorStoreFile(req: DeleteVectorStoreFileRequest, initReq?: fm.InitReq): Promise<DeleteVectorStoreFileResponse>;
+    static CreateVectorStoreFileBatch(req: CreateVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch>;
+    static GetVectorStoreFileBatch(req: GetVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch>;
+    static CancelVectorStoreFileBatch(req: CancelVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch>;
+    static ListFilesInVectorStoreBatch(req: ListFilesInVectorStoreBatchRequest, initReq?: fm.InitReq): Promise<ListVectorStoreFilesResponse>;
+    static SearchVectorStore(req: VectorStoreSearchRequest, initReq?: fm.InitReq): Promise<VectorStoreSearchResponse>;
 }
 export declare class VectorStoreInternalService {
     static SearchVectorStore(req: SearchVectorStoreRequest, initReq?: fm.InitReq): Promise<SearchVectorStoreResponse>;
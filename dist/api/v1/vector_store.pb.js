@@ -35,6 +35,21 @@ export class VectorStoreService {
     static DeleteVectorStoreFile(req, initReq) {
         return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/files/${req["fileId"]}`, Object.assign(Object.assign({}, initReq), { method: "DELETE" }));
     }
+    static CreateVectorStoreFileBatch(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static GetVectorStoreFileBatch(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}?${fm.renderURLSearchParams(req, ["vectorStoreId", "batchId"])}`, Object.assign(Object.assign({}, initReq), { method: "GET" }));
+    }
+    static CancelVectorStoreFileBatch(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}/cancel`, Object.assign(Object.assign({}, initReq), { method: "POST" }));
+    }
+    static ListFilesInVectorStoreBatch(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}/files?${fm.renderURLSearchParams(req, ["vectorStoreId", "batchId"])}`, Object.assign(Object.assign({}, initReq), { method: "GET" }));
+    }
+    static SearchVectorStore(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vectorStoreId"]}/search`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
 }
 export class VectorStoreInternalService {
     static SearchVectorStore(req, initReq) {
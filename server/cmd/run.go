@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 
 	"github.com/go-logr/stdr"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -18,10 +20,15 @@ import (
 	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/ollama"
+	"github.com/llmariner/vector-store-manager/server/internal/reaper"
+	"github.com/llmariner/vector-store-manager/server/internal/reconciler"
+	"github.com/llmariner/vector-store-manager/server/internal/rerank"
 	"github.com/llmariner/vector-store-manager/server/internal/s3"
 	"github.com/llmariner/vector-store-manager/server/internal/server"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/tokenizer"
 	"github.com/llmariner/vector-store-manager/server/internal/vllm"
+	"github.com/llmariner/vector-store-manager/server/internal/worker"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -112,30 +119,49 @@ func run(ctx context.Context, c *config.Config) error {
 	}
 
 	var llm embedder.LLMClient
-	var dim int
+	var dims embedder.DimensionsFunc
 	switch c.LLMEngine {
 	case llmkind.Ollama:
 		llm = ollama.New(c.LLMEngineAddr)
-		dim, err = ollama.Dimension(c.Model)
-		if err != nil {
-			return err
-		}
+		dims = ollama.Dimension
 	case llmkind.VLLM:
 		llm = vllm.NewClient(c.LLMEngineAddr, logger)
-		dim, err = vllm.Dimension(c.Model)
-		if err != nil {
-			return err
-		}
+		dims = vllm.Dimension
 	default:
 		return fmt.Errorf("unsupported llm engine: %s", c.LLMEngine)
 	}
+	models := embedder.NewModelRegistry(llm, c.ModelDimensions, dims, logger)
+	// The LLM engine might not be ready yet. Unsupported models are rejected when vector stores are created.
+	if _, err := models.Dimensions(ctx, c.Model); err != nil {
+		log.Error(err, "Failed to get the dimensions of the default model", "model", c.Model)
+	}
 	s3Client, err := s3.NewClient(ctx, c.ObjectStore.S3)
 	if err != nil {
 		return err
 	}
-	e := embedder.New(llm, s3Client, vstoreClient, logger)
+	var reranker embedder.Reranker
+	if rc := c.Reranker; rc.Enable {
+		baseURL := rc.BaseURL
+		if baseURL == "" {
+			baseURL = fmt.Sprintf("http://%s/v1", c.LLMEngineAddr)
+		}
+		var apiKey string
+		if rc.APIKeyEnvName != "" {
+			apiKey = os.Getenv(rc.APIKeyEnvName)
+		}
+		reranker = rerank.NewClient(baseURL, rc.Model, apiKey, logger)
+	}
+	tokenizers := map[string]embedder.Tokenizer{}
+	for model, tc := range c.Tokenizers {
+		t, err := tokenizer.Load(tc)
+		if err != nil {
+			return fmt.Errorf("load tokenizer of model %q: %s", model, err)
+		}
+		tokenizers[model] = t
+	}
+	e := embedder.New(llm, s3Client, vstoreClient, st, reranker, tokenizers, c.Embedding, logger)
 
-	s := server.New(st, fclient, fwClient, vstoreClient, e, c.Model, dim, logger)
+	s := server.New(st, fclient, vstoreClient, e, c.Model, models, c.Quotas, logger)
 
 	usage, err := sender.New(ctx, c.UsageSender, grpc.WithTransportCredentials(insecure.NewCredentials()), logger)
 	if err != nil {
@@ -153,10 +179,39 @@ func run(ctx context.Context, c *config.Config) error {
 		errCh <- s.Run(ctx, c.GRPCPort, c.AuthConfig, usage)
 	}()
 
+	if c.MetricsPort > 0 {
+		go func() {
+			// Serve metrics on a separate port as they are not part of the API.
+			mux := http.NewServeMux()
+			mux.Handle("/debug/vars", expvar.Handler())
+			log.Info("Starting metrics server...", "port", c.MetricsPort)
+			errCh <- http.ListenAndServe(fmt.Sprintf(":%d", c.MetricsPort), mux)
+		}()
+	}
+
 	go func() {
-		s := server.NewInternal(c.Model, e, logger)
+		s := server.NewInternal(st, e, logger)
 		errCh <- s.Run(c.InternalGRPCPort)
 	}()
 
+	go func() {
+		w := worker.New(st, fwClient, e, c.Ingestion, c.Quotas, logger)
+		errCh <- w.Run(ctx)
+	}()
+
+	if c.Expiration.Enable {
+		go func() {
+			r := reaper.New(st, vstoreClient, c.Expiration, logger)
+			errCh <- r.Run(ctx)
+		}()
+	}
+
+	if c.Reconciliation.Enable {
+		go func() {
+			r := reconciler.New(st, vstoreClient, c.Reconciliation, logger)
+			errCh <- r.Run(ctx)
+		}()
+	}
+
 	return <-errCh
 }
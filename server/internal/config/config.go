@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/llm-operator/inference-manager/pkg/llmkind"
 	"github.com/llmariner/api-usage/pkg/sender"
@@ -70,11 +71,255 @@ func (c *AuthConfig) Validate() error {
 	return nil
 }
 
+// IngestionConfig is the configuration for the workers that embed files in the background.
+type IngestionConfig struct {
+	// NumWorkers is the number of files that are embedded concurrently.
+	NumWorkers int `yaml:"numWorkers"`
+	// PollingInterval is the interval to check for pending files.
+	PollingInterval time.Duration `yaml:"pollingInterval"`
+	// ProcessingTimeout is the maximum duration for embedding a single file. A file
+	// that is not completed within this duration is retried.
+	ProcessingTimeout time.Duration `yaml:"processingTimeout"`
+	// MaxAttempts is the maximum number of attempts to embed a file. A file that is not completed within the
+	// attempts, e.g., because it crashes the server, is marked as failed. Defaults to 3 if not set.
+	MaxAttempts int `yaml:"maxAttempts"`
+}
+
+// Validate validates the ingestion configuration.
+func (c *IngestionConfig) Validate() error {
+	if c.NumWorkers <= 0 {
+		return fmt.Errorf("numWorkers must be greater than 0")
+	}
+	if c.PollingInterval <= 0 {
+		return fmt.Errorf("pollingInterval must be greater than 0")
+	}
+	if c.ProcessingTimeout <= 0 {
+		return fmt.Errorf("processingTimeout must be greater than 0")
+	}
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("maxAttempts must not be negative")
+	}
+	return nil
+}
+
+// ExpirationConfig is the configuration for expiring vector stores in the background.
+type ExpirationConfig struct {
+	Enable bool `yaml:"enable"`
+	// CheckInterval is the interval to check for vector stores that have passed their expiration time.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+	// DropExpiredCollections drops the Milvus collections of expired vector stores to free memory. The files of
+	// the vector stores are kept until the vector stores are deleted.
+	DropExpiredCollections bool `yaml:"dropExpiredCollections"`
+}
+
+// Validate validates the expiration configuration.
+func (c *ExpirationConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.CheckInterval <= 0 {
+		return fmt.Errorf("checkInterval must be greater than 0")
+	}
+	return nil
+}
+
+// ReconciliationConfig is the configuration for reconciling the vector stores in the database with the collections
+// in the vector database.
+type ReconciliationConfig struct {
+	Enable bool `yaml:"enable"`
+	// Interval is the interval to compare the vector stores with the collections.
+	Interval time.Duration `yaml:"interval"`
+	// GracePeriod is the duration for which a collection without a vector store is kept before it is dropped. It
+	// must be long enough for the vector stores being created or deleted to be committed.
+	GracePeriod time.Duration `yaml:"gracePeriod"`
+	// DryRun only reports inconsistencies without dropping collections or updating vector stores.
+	DryRun bool `yaml:"dryRun"`
+}
+
+// Validate validates the reconciliation configuration.
+func (c *ReconciliationConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+	if c.GracePeriod <= 0 {
+		return fmt.Errorf("gracePeriod must be greater than 0")
+	}
+	return nil
+}
+
+// QuotaConfig is the configuration of the limits on the resources used by a project. Zero means no limit.
+type QuotaConfig struct {
+	// MaxVectorStores is the maximum number of vector stores in a project.
+	MaxVectorStores int64 `yaml:"maxVectorStores"`
+	// MaxFilesPerVectorStore is the maximum number of files in a vector store.
+	MaxFilesPerVectorStore int64 `yaml:"maxFilesPerVectorStore"`
+	// MaxUsageBytes is the maximum total usage of the vector stores in a project in bytes.
+	MaxUsageBytes int64 `yaml:"maxUsageBytes"`
+	// MaxChunksPerFile is the maximum number of chunks that a file is split into.
+	MaxChunksPerFile int `yaml:"maxChunksPerFile"`
+}
+
+func (c *QuotaConfig) validate() error {
+	if c.MaxVectorStores < 0 {
+		return fmt.Errorf("maxVectorStores must not be negative")
+	}
+	if c.MaxFilesPerVectorStore < 0 {
+		return fmt.Errorf("maxFilesPerVectorStore must not be negative")
+	}
+	if c.MaxUsageBytes < 0 {
+		return fmt.Errorf("maxUsageBytes must not be negative")
+	}
+	if c.MaxChunksPerFile < 0 {
+		return fmt.Errorf("maxChunksPerFile must not be negative")
+	}
+	return nil
+}
+
+// QuotasConfig is the configuration of the quotas of projects.
+type QuotasConfig struct {
+	// Default is the quota of projects without overrides.
+	Default QuotaConfig `yaml:"default"`
+	// Projects overrides the default quota, keyed by project ID. Zero fields of an override take the default values.
+	Projects map[string]QuotaConfig `yaml:"projects"`
+}
+
+// Validate validates the quotas configuration.
+func (c *QuotasConfig) Validate() error {
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("default: %s", err)
+	}
+	for p, q := range c.Projects {
+		if err := q.validate(); err != nil {
+			return fmt.Errorf("projects: %q: %s", p, err)
+		}
+	}
+	return nil
+}
+
+// ForProject returns the quota of the project.
+func (c *QuotasConfig) ForProject(projectID string) QuotaConfig {
+	q := c.Default
+	o, ok := c.Projects[projectID]
+	if !ok {
+		return q
+	}
+	if o.MaxVectorStores > 0 {
+		q.MaxVectorStores = o.MaxVectorStores
+	}
+	if o.MaxFilesPerVectorStore > 0 {
+		q.MaxFilesPerVectorStore = o.MaxFilesPerVectorStore
+	}
+	if o.MaxUsageBytes > 0 {
+		q.MaxUsageBytes = o.MaxUsageBytes
+	}
+	if o.MaxChunksPerFile > 0 {
+		q.MaxChunksPerFile = o.MaxChunksPerFile
+	}
+	return q
+}
+
+// EmbeddingConfig is the configuration for embedding requests to the LLM engine.
+type EmbeddingConfig struct {
+	// BatchSize is the maximum number of chunks embedded in a single request.
+	BatchSize int `yaml:"batchSize"`
+	// Concurrency is the maximum number of concurrent embedding requests for a single file.
+	Concurrency int `yaml:"concurrency"`
+	// InsertBatchSize is the number of chunks inserted into the vector database at once. It bounds the number of
+	// embeddings held in memory while a file is embedded.
+	InsertBatchSize int `yaml:"insertBatchSize"`
+	// ContextLengths are the maximum numbers of input tokens of embedding models, keyed by model name. The auto
+	// chunking strategy chooses chunk sizes that fit in the context length. 512 is assumed for other models.
+	ContextLengths map[string]int `yaml:"contextLengths"`
+}
+
+// Validate validates the embedding configuration.
+func (c *EmbeddingConfig) Validate() error {
+	if c.BatchSize <= 0 {
+		return fmt.Errorf("batchSize must be greater than 0")
+	}
+	if c.Concurrency <= 0 {
+		return fmt.Errorf("concurrency must be greater than 0")
+	}
+	if c.InsertBatchSize <= 0 {
+		return fmt.Errorf("insertBatchSize must be greater than 0")
+	}
+	for m, l := range c.ContextLengths {
+		if l <= 0 {
+			return fmt.Errorf("contextLengths: context length of %q must be greater than 0", m)
+		}
+	}
+	return nil
+}
+
+// RerankerConfig is the configuration for reranking search results.
+type RerankerConfig struct {
+	Enable bool `yaml:"enable"`
+	// BaseURL is the base URL of a rerank endpoint compatible with the Cohere rerank API (e.g., "http://reranker:8080/v1").
+	// The LLM engine is used if not set.
+	BaseURL string `yaml:"baseUrl"`
+	// Model is the name of the reranking model.
+	Model string `yaml:"model"`
+	// APIKeyEnvName is the name of the environment variable that holds the API key of the rerank endpoint.
+	APIKeyEnvName string `yaml:"apiKeyEnvName"`
+}
+
+// Validate validates the reranker configuration.
+func (c *RerankerConfig) Validate(llmEngine string) error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Model == "" {
+		return fmt.Errorf("model must be set")
+	}
+	if c.BaseURL == "" && llmEngine != llmkind.VLLM {
+		// Ollama does not serve a rerank endpoint.
+		return fmt.Errorf("baseUrl must be set unless the LLM engine is %q", llmkind.VLLM)
+	}
+	return nil
+}
+
+// TokenizerConfig is the configuration of the tokenizer that measures the chunk sizes for an embedding model.
+// Exactly one of TiktokenFile and HuggingFaceFile must be set. Files are loaded from the local disk.
+type TokenizerConfig struct {
+	// TiktokenEncoding is the name of the tiktoken encoding (e.g., "cl100k_base").
+	TiktokenEncoding string `yaml:"tiktokenEncoding"`
+	// TiktokenFile is the path to the BPE file of the tiktoken encoding (e.g., "cl100k_base.tiktoken").
+	TiktokenFile string `yaml:"tiktokenFile"`
+
+	// HuggingFaceFile is the path to the tokenizer.json of a Hugging Face model.
+	HuggingFaceFile string `yaml:"huggingFaceFile"`
+}
+
+// Validate validates the tokenizer configuration.
+func (c *TokenizerConfig) Validate() error {
+	switch {
+	case c.TiktokenFile != "" && c.HuggingFaceFile != "":
+		return fmt.Errorf("only one of tiktokenFile and huggingFaceFile can be set")
+	case c.TiktokenFile != "":
+		if c.TiktokenEncoding == "" {
+			return fmt.Errorf("tiktokenEncoding must be set")
+		}
+	case c.HuggingFaceFile != "":
+		if c.TiktokenEncoding != "" {
+			return fmt.Errorf("tiktokenEncoding cannot be set with huggingFaceFile")
+		}
+	default:
+		return fmt.Errorf("either tiktokenFile or huggingFaceFile must be set")
+	}
+	return nil
+}
+
 // Config is the configuration.
 type Config struct {
 	GRPCPort         int `yaml:"grpcPort"`
 	HTTPPort         int `yaml:"httpPort"`
 	InternalGRPCPort int `yaml:"internalGrpcPort"`
+	// MetricsPort is the port of the HTTP server that exports metrics at /debug/vars. The server is not started if
+	// the port is not set. It must not be exposed to API clients.
+	MetricsPort int `yaml:"metricsPort"`
 
 	LLMEngine                     string `yaml:"llmEngine"`
 	LLMEngineAddr                 string `yaml:"llmEngineAddr"`
@@ -87,6 +332,20 @@ type Config struct {
 
 	// Model is the embedding model name.
 	Model string `yaml:"model"`
+	// ModelDimensions overrides the dimensions of embedding models, keyed by model name. The dimensions of
+	// other models are discovered from the LLM engine.
+	ModelDimensions map[string]int `yaml:"modelDimensions"`
+	// Tokenizers are the tokenizers used to measure chunk sizes, keyed by embedding model name. The chunk sizes
+	// of other models are estimated from the number of characters.
+	Tokenizers map[string]TokenizerConfig `yaml:"tokenizers"`
+
+	Embedding  EmbeddingConfig  `yaml:"embedding"`
+	Ingestion  IngestionConfig  `yaml:"ingestion"`
+	Expiration ExpirationConfig `yaml:"expiration"`
+	Reranker   RerankerConfig   `yaml:"reranker"`
+
+	Reconciliation ReconciliationConfig `yaml:"reconciliation"`
+	Quotas         QuotasConfig         `yaml:"quotas"`
 
 	AuthConfig  AuthConfig    `yaml:"auth"`
 	UsageSender sender.Config `yaml:"usageSender"`
@@ -103,6 +362,9 @@ func (c *Config) Validate() error {
 	if c.InternalGRPCPort <= 0 {
 		return fmt.Errorf("internalGrpcPort must be greater than 0")
 	}
+	if c.MetricsPort < 0 {
+		return fmt.Errorf("metricsPort must not be negative")
+	}
 	if c.LLMEngineAddr == "" {
 		return fmt.Errorf("LLM engine addr must be set")
 	}
@@ -115,6 +377,16 @@ func (c *Config) Validate() error {
 	if c.Model == "" {
 		return fmt.Errorf("model must be set")
 	}
+	for m, d := range c.ModelDimensions {
+		if d <= 0 {
+			return fmt.Errorf("modelDimensions: dimensions of %q must be greater than 0", m)
+		}
+	}
+	for m, tc := range c.Tokenizers {
+		if err := tc.Validate(); err != nil {
+			return fmt.Errorf("tokenizers: %q: %s", m, err)
+		}
+	}
 	if err := c.VectorDatabase.Validate(); err != nil {
 		return fmt.Errorf("vector database: %s", err)
 	}
@@ -124,6 +396,24 @@ func (c *Config) Validate() error {
 	if err := c.ObjectStore.Validate(); err != nil {
 		return fmt.Errorf("object store: %s", err)
 	}
+	if err := c.Embedding.Validate(); err != nil {
+		return fmt.Errorf("embedding: %s", err)
+	}
+	if err := c.Ingestion.Validate(); err != nil {
+		return fmt.Errorf("ingestion: %s", err)
+	}
+	if err := c.Expiration.Validate(); err != nil {
+		return fmt.Errorf("expiration: %s", err)
+	}
+	if err := c.Reranker.Validate(c.LLMEngine); err != nil {
+		return fmt.Errorf("reranker: %s", err)
+	}
+	if err := c.Reconciliation.Validate(); err != nil {
+		return fmt.Errorf("reconciliation: %s", err)
+	}
+	if err := c.Quotas.Validate(); err != nil {
+		return fmt.Errorf("quotas: %s", err)
+	}
 	if err := c.AuthConfig.Validate(); err != nil {
 		return err
 	}
@@ -0,0 +1,275 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// defaultContextLengthTokens is the context length of embedding models that are not configured. Most BERT-based
+	// embedding models accept 512 tokens.
+	defaultContextLengthTokens = 512
+
+	// minAutoChunkSizeTokens and maxAutoChunkSizeTokens bound the chunk size chosen by the auto chunking strategy.
+	// Larger chunks dilute the embeddings even if the model accepts them.
+	minAutoChunkSizeTokens = 100
+	maxAutoChunkSizeTokens = 800
+)
+
+// ChunkingMethod is the method chosen by the auto chunking strategy to split a file.
+type ChunkingMethod string
+
+const (
+	// ChunkingMethodHeadings splits Markdown and HTML files into sections by headings.
+	ChunkingMethodHeadings ChunkingMethod = "headings"
+	// ChunkingMethodPages splits PDF files page by page so that no chunk spans pages.
+	ChunkingMethodPages ChunkingMethod = "pages"
+	// ChunkingMethodSentences packs consecutive sentences of prose into chunks.
+	ChunkingMethodSentences ChunkingMethod = "sentences"
+	// ChunkingMethodCode splits source code at the declarations introduced by keywords of the language.
+	ChunkingMethodCode ChunkingMethod = "code"
+	// ChunkingMethodRecords splits CSV and JSON files into records.
+	ChunkingMethodRecords ChunkingMethod = "records"
+)
+
+// Chunking is the strategy to split a file into chunks.
+type Chunking struct {
+	// Auto is true if the method and the sizes are chosen from the type of the file and the context length of
+	// the embedding model. Otherwise, the file is split with the given sizes.
+	Auto bool
+
+	// Method is the method chosen by the auto chunking strategy.
+	Method             ChunkingMethod
+	MaxChunkSizeTokens int64
+	ChunkOverlapTokens int64
+
+	// Semantic is set if the file is split where the topic shifts.
+	Semantic *SemanticChunking
+
+	// MaxChunks is the maximum number of chunks that the file can be split into. Zero means no limit.
+	MaxChunks int
+}
+
+// SemanticChunking is the parameters of the semantic chunking strategy.
+type SemanticChunking struct {
+	// BreakpointPercentile is the percentile of the distances between adjacent sentences above which a new chunk
+	// starts.
+	BreakpointPercentile int
+	// BufferSize is the number of sentences on each side of a sentence that are embedded together with it.
+	BufferSize int
+}
+
+// autoChunking chooses the chunking method and sizes for a file type. The chunk size fits in the context length.
+func autoChunking(ftype fileType, contextLength int) Chunking {
+	var method ChunkingMethod
+	switch ftype {
+	case fileTypeMarkdown, fileTypeHTML:
+		method = ChunkingMethodHeadings
+	case fileTypePDF:
+		method = ChunkingMethodPages
+	case fileTypeCode:
+		method = ChunkingMethodCode
+	case fileTypeCSV, fileTypeJSON, fileTypeJSONL:
+		method = ChunkingMethodRecords
+	default:
+		method = ChunkingMethodSentences
+	}
+
+	size := int64(min(max(contextLength, minAutoChunkSizeTokens), maxAutoChunkSizeTokens))
+	var overlap int64
+	switch method {
+	case ChunkingMethodHeadings, ChunkingMethodCode:
+		// Sections and declarations are self-contained.
+		overlap = size / 8
+	case ChunkingMethodRecords:
+		// Records are independent of each other.
+		overlap = 0
+	default:
+		overlap = size / 4
+	}
+	return Chunking{
+		Auto:               true,
+		Method:             method,
+		MaxChunkSizeTokens: size,
+		ChunkOverlapTokens: overlap,
+	}
+}
+
+var sentenceBoundaryRe = regexp.MustCompile(`[.!?]+["'’”)\]]*\s+|[。！？]+\s*|\n\s*\n`)
+
+// splitSentences splits a text into sentences at sentence-ending punctuation and blank lines.
+func splitSentences(text string) []string {
+	var sents []string
+	var start int
+	for _, m := range sentenceBoundaryRe.FindAllStringIndex(text, -1) {
+		if s := strings.TrimSpace(text[start:m[1]]); s != "" {
+			sents = append(sents, s)
+		}
+		start = m[1]
+	}
+	if s := strings.TrimSpace(text[start:]); s != "" {
+		sents = append(sents, s)
+	}
+	return sents
+}
+
+// sentenceSplitter packs consecutive sentences into chunks. Chunks overlap by whole sentences.
+type sentenceSplitter struct {
+	chunkSize    int
+	chunkOverlap int
+	lenFunc      func(string) int
+	// long splits sentences that do not fit in a chunk.
+	long textsplitter.TextSplitter
+}
+
+var _ textsplitter.TextSplitter = (*sentenceSplitter)(nil)
+
+// SplitText splits a text into chunks.
+func (s *sentenceSplitter) SplitText(text string) ([]string, error) {
+	sents, err := s.sentences(text)
+	if err != nil {
+		return nil, err
+	}
+	return s.pack(sents), nil
+}
+
+// sentences splits a text into sentences. Sentences that do not fit in a chunk are split further.
+func (s *sentenceSplitter) sentences(text string) ([]string, error) {
+	var sents []string
+	for _, sent := range splitSentences(text) {
+		if s.lenFunc(sent) <= s.chunkSize {
+			sents = append(sents, sent)
+			continue
+		}
+		parts, err := s.long.SplitText(sent)
+		if err != nil {
+			return nil, err
+		}
+		sents = append(sents, parts...)
+	}
+	return sents, nil
+}
+
+// pack packs consecutive sentences into chunks.
+func (s *sentenceSplitter) pack(sents []string) []string {
+	var chunks []string
+	var cur []string
+	for _, sent := range sents {
+		if len(cur) > 0 && s.lenFunc(strings.Join(append(cur, sent), " ")) > s.chunkSize {
+			chunks = append(chunks, strings.Join(cur, " "))
+			cur = s.overlap(cur, sent)
+		}
+		cur = append(cur, sent)
+	}
+	if len(cur) > 0 {
+		chunks = append(chunks, strings.Join(cur, " "))
+	}
+	return chunks
+}
+
+// overlap returns the trailing sentences of a chunk that are carried over to the next chunk. The sentences fit in
+// the overlap and leave room for the next sentence.
+func (s *sentenceSplitter) overlap(sents []string, next string) []string {
+	var keep []string
+	for i := len(sents) - 1; i >= 0; i-- {
+		cand := append([]string{sents[i]}, keep...)
+		joined := strings.Join(cand, " ")
+		if s.lenFunc(joined) > s.chunkOverlap || s.lenFunc(joined+" "+next) > s.chunkSize {
+			break
+		}
+		keep = cand
+	}
+	return keep
+}
+
+// embedFunc embeds texts with the embedding model of a collection.
+type embedFunc func(ctx context.Context, texts []string) ([][]float32, error)
+
+// semanticSplitter splits a text where the topic shifts. Each sentence is embedded together with its neighbors,
+// and a new chunk starts where the cosine distance between adjacent sentences is above the given percentile of all
+// distances in the text. Chunks that are still too large are packed sentence by sentence without overlap.
+type semanticSplitter struct {
+	// ctx is the context to embed sentences. The TextSplitter interface does not take one.
+	ctx   context.Context
+	embed embedFunc
+
+	breakpointPercentile int
+	bufferSize           int
+	sentences            *sentenceSplitter
+}
+
+var _ textsplitter.TextSplitter = (*semanticSplitter)(nil)
+
+// SplitText splits a text into chunks.
+func (s *semanticSplitter) SplitText(text string) ([]string, error) {
+	sents, err := s.sentences.sentences(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(sents) <= 1 {
+		return sents, nil
+	}
+
+	windows := make([]string, len(sents))
+	for i := range sents {
+		start, end := max(i-s.bufferSize, 0), min(i+s.bufferSize+1, len(sents))
+		windows[i] = strings.Join(sents[start:end], " ")
+	}
+	embeddings, err := s.embed(s.ctx, windows)
+	if err != nil {
+		return nil, fmt.Errorf("embed sentences: %w", err)
+	}
+	if len(embeddings) != len(windows) {
+		return nil, fmt.Errorf("got %d embeddings for %d sentences", len(embeddings), len(windows))
+	}
+
+	dists := make([]float64, len(sents)-1)
+	for i := range dists {
+		dists[i] = 1 - cosineSimilarity(embeddings[i], embeddings[i+1])
+	}
+	threshold := percentile(dists, s.breakpointPercentile)
+
+	var chunks []string
+	var start int
+	for i, d := range dists {
+		if d <= threshold {
+			continue
+		}
+		chunks = append(chunks, s.sentences.pack(sents[start:i+1])...)
+		start = i + 1
+	}
+	return append(chunks, s.sentences.pack(sents[start:])...), nil
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors. It returns 0 if either vector is zero.
+func cosineSimilarity(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range min(len(a), len(b)) {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// percentile returns the p-th percentile of values with linear interpolation between the closest ranks.
+func percentile(values []float64, p int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	rank := float64(p) / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
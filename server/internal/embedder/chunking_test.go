@@ -0,0 +1,292 @@
+package embedder
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+func TestAutoChunking(t *testing.T) {
+	tcs := []struct {
+		name          string
+		ftype         fileType
+		contextLength int
+		want          Chunking
+	}{
+		{
+			name:          "markdown",
+			ftype:         fileTypeMarkdown,
+			contextLength: 512,
+			want:          Chunking{Auto: true, Method: ChunkingMethodHeadings, MaxChunkSizeTokens: 512, ChunkOverlapTokens: 64},
+		},
+		{
+			name:          "html",
+			ftype:         fileTypeHTML,
+			contextLength: 256,
+			want:          Chunking{Auto: true, Method: ChunkingMethodHeadings, MaxChunkSizeTokens: 256, ChunkOverlapTokens: 32},
+		},
+		{
+			name:          "pdf",
+			ftype:         fileTypePDF,
+			contextLength: 8192,
+			want:          Chunking{Auto: true, Method: ChunkingMethodPages, MaxChunkSizeTokens: 800, ChunkOverlapTokens: 200},
+		},
+		{
+			name:          "text",
+			ftype:         fileTypeText,
+			contextLength: 512,
+			want:          Chunking{Auto: true, Method: ChunkingMethodSentences, MaxChunkSizeTokens: 512, ChunkOverlapTokens: 128},
+		},
+		{
+			name:          "docx",
+			ftype:         fileTypeDOCX,
+			contextLength: 64,
+			want:          Chunking{Auto: true, Method: ChunkingMethodSentences, MaxChunkSizeTokens: 100, ChunkOverlapTokens: 25},
+		},
+		{
+			name:          "code",
+			ftype:         fileTypeCode,
+			contextLength: 512,
+			want:          Chunking{Auto: true, Method: ChunkingMethodCode, MaxChunkSizeTokens: 512, ChunkOverlapTokens: 64},
+		},
+		{
+			name:          "csv",
+			ftype:         fileTypeCSV,
+			contextLength: 512,
+			want:          Chunking{Auto: true, Method: ChunkingMethodRecords, MaxChunkSizeTokens: 512, ChunkOverlapTokens: 0},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, autoChunking(tc.ftype, tc.contextLength))
+		})
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	got := splitSentences("Hello world. How are you?  I'm fine!\n\nNew paragraph\nwithout a period\n\n東京です。大阪です。")
+	want := []string{
+		"Hello world.",
+		"How are you?",
+		"I'm fine!",
+		"New paragraph\nwithout a period",
+		"東京です。",
+		"大阪です。",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestSentenceSplitter(t *testing.T) {
+	tcs := []struct {
+		name         string
+		text         string
+		chunkSize    int
+		chunkOverlap int
+		want         []string
+	}{
+		{
+			name:      "pack sentences",
+			text:      "One two. Three four. Five six. Seven.",
+			chunkSize: 20,
+			want: []string{
+				"One two. Three four.",
+				"Five six. Seven.",
+			},
+		},
+		{
+			name:         "overlap by sentences",
+			text:         "One two. Three four. Five six. Seven.",
+			chunkSize:    20,
+			chunkOverlap: 10,
+			want: []string{
+				"One two. Three four.",
+				"Five six. Seven.",
+			},
+		},
+		{
+			name:         "overlap with short sentences",
+			text:         "A. B. C. D. E.",
+			chunkSize:    6,
+			chunkOverlap: 2,
+			want: []string{
+				"A. B.",
+				"B. C.",
+				"C. D.",
+				"D. E.",
+			},
+		},
+		{
+			name:      "long sentence",
+			text:      "Short. This sentence is longer than a chunk.",
+			chunkSize: 20,
+			want: []string{
+				"Short.",
+				"This sentence is",
+				"longer than a chunk.",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &sentenceSplitter{
+				chunkSize:    tc.chunkSize,
+				chunkOverlap: tc.chunkOverlap,
+				lenFunc:      utf8.RuneCountInString,
+				long: textsplitter.NewRecursiveCharacter(
+					textsplitter.WithChunkSize(tc.chunkSize),
+					textsplitter.WithChunkOverlap(0),
+				),
+			}
+			got, err := s.SplitText(tc.text)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			for _, c := range got {
+				assert.LessOrEqual(t, utf8.RuneCountInString(c), tc.chunkSize)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{0.4, 0.1, 0.3, 0.2, 0.5}
+	assert.InDelta(t, 0.1, percentile(values, 0), 1e-9)
+	assert.InDelta(t, 0.3, percentile(values, 50), 1e-9)
+	assert.InDelta(t, 0.48, percentile(values, 95), 1e-9)
+	assert.InDelta(t, 0.5, percentile(values, 100), 1e-9)
+	assert.Zero(t, percentile(nil, 95))
+}
+
+func TestSemanticSplitter(t *testing.T) {
+	// Sentences about cats and dogs are embedded to orthogonal vectors.
+	embed := func(ctx context.Context, texts []string) ([][]float32, error) {
+		var es [][]float32
+		for _, text := range texts {
+			e := []float32{0, 0}
+			if strings.Contains(text, "Cats") {
+				e[0] = float32(strings.Count(text, "Cats"))
+			}
+			if strings.Contains(text, "Dogs") {
+				e[1] = float32(strings.Count(text, "Dogs"))
+			}
+			es = append(es, e)
+		}
+		return es, nil
+	}
+
+	tcs := []struct {
+		name       string
+		text       string
+		bufferSize int
+		chunkSize  int
+		want       []string
+	}{
+		{
+			name:       "split at topic shift",
+			text:       "Cats purr. Cats nap. Cats climb. Dogs bark. Dogs fetch.",
+			bufferSize: 0,
+			chunkSize:  100,
+			want: []string{
+				"Cats purr. Cats nap. Cats climb.",
+				"Dogs bark. Dogs fetch.",
+			},
+		},
+		{
+			name:       "pack large group",
+			text:       "Cats purr. Cats nap. Cats climb. Dogs bark. Dogs fetch.",
+			bufferSize: 0,
+			chunkSize:  25,
+			want: []string{
+				"Cats purr. Cats nap.",
+				"Cats climb.",
+				"Dogs bark. Dogs fetch.",
+			},
+		},
+		{
+			name:       "single sentence",
+			text:       "Cats purr.",
+			bufferSize: 1,
+			chunkSize:  100,
+			want:       []string{"Cats purr."},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &semanticSplitter{
+				ctx:                  context.Background(),
+				embed:                embed,
+				breakpointPercentile: 95,
+				bufferSize:           tc.bufferSize,
+				sentences: &sentenceSplitter{
+					chunkSize: tc.chunkSize,
+					lenFunc:   utf8.RuneCountInString,
+					long: textsplitter.NewRecursiveCharacter(
+						textsplitter.WithChunkSize(tc.chunkSize),
+						textsplitter.WithChunkOverlap(0),
+					),
+				},
+			}
+			got, err := s.SplitText(tc.text)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	got, err := htmlToMarkdown(strings.NewReader(`<html><head><title>T</title><style>p {}</style></head><body>
+<h1>Install</h1>
+<p>Run <b>make</b>.</p>
+<script>alert(1)</script>
+<h2>Options</h2>
+<ul><li>a</li><li>b</li></ul>
+</body></html>`))
+	assert.NoError(t, err)
+	assert.Equal(t, "# Install\n\nRun make.\n\n## Options\n\na\n\nb", got)
+}
+
+func TestSplitFile_Auto(t *testing.T) {
+	tcs := []struct {
+		name       string
+		fileName   string
+		content    string
+		wantMethod ChunkingMethod
+		want       []string
+	}{
+		{
+			name:       "html",
+			fileName:   "page.html",
+			content:    "<html><body><h1>Install</h1><p>Run make.</p><h1>Usage</h1><p>Run the binary.</p></body></html>",
+			wantMethod: ChunkingMethodHeadings,
+			want: []string{
+				"# Install\nRun make.",
+				"# Usage\nRun the binary.",
+			},
+		},
+		{
+			name:       "text",
+			fileName:   "notes.txt",
+			content:    "First sentence. Second sentence.",
+			wantMethod: ChunkingMethodSentences,
+			want: []string{
+				"First sentence. Second sentence.",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeTempFile(t, []byte(tc.content))
+			docs, chunking, err := splitFile(context.Background(), path, tc.fileName, Chunking{Auto: true}, 512, nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMethod, chunking.Method)
+			var got []string
+			for _, d := range docs {
+				got = append(got, d.PageContent)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
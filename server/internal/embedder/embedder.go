@@ -2,36 +2,98 @@ package embedder
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
+	"sort"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
-	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"github.com/tmc/langchaingo/schema"
 	"github.com/tmc/langchaingo/textsplitter"
 )
 
 const (
 	charactersPerToken = 4
+
+	// rerankCandidateMultiplier is the number of candidates retrieved for each requested document when results are reranked.
+	rerankCandidateMultiplier = 4
+
+	// bytesPerDimension is the size of a dimension of an embedding stored as float32.
+	bytesPerDimension = 4
+	// primaryKeyBytes is the size of the int64 primary key of a chunk.
+	primaryKeyBytes = 8
+)
+
+var (
+	// ErrRateLimitExceeded is returned when the LLM engine or the reranker rejects a request due to rate limiting.
+	ErrRateLimitExceeded = errors.New("embedder: rate limit exceeded")
+
+	// ErrRerankerNotConfigured is returned when reranking is requested but no reranker is configured.
+	ErrRerankerNotConfigured = errors.New("embedder: reranker not configured")
+
+	// ErrUnknownModel is returned when the LLM engine does not serve the requested model.
+	ErrUnknownModel = errors.New("embedder: unknown model")
 )
 
 // LLMClient is an interface to handle embedding requests.
 type LLMClient interface {
 	Embed(ctx context.Context, modelName, prompt string) ([]float32, error)
+	// EmbedBatch returns the embeddings of the prompts in the same order as the prompts.
+	EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error)
 	PullModel(ctx context.Context, modelName string) error
 }
 
+// Reranker is an interface to score the relevance of documents to a query, typically with a cross-encoder.
+type Reranker interface {
+	// Rerank returns the relevance scores of the documents in the same order as the documents. A higher score means more relevant.
+	Rerank(ctx context.Context, query string, documents []string) ([]float32, error)
+}
+
+// Tokenizer counts the tokens of texts with the tokenizer of an embedding model.
+type Tokenizer interface {
+	CountTokens(text string) int
+}
+
 // s3Client is an interface for an S3 client.
 type s3Client interface {
 	Download(ctx context.Context, w io.WriterAt, key string) error
 }
 
 type vstoreClient interface {
-	InsertDocuments(ctx context.Context, collectionName string, files, texts []string, vectors [][]float32) error
+	InsertDocuments(
+		ctx context.Context,
+		collectionName string,
+		files,
+		texts []string,
+		metadata []milvus.ChunkMetadata,
+		vectors [][]float32,
+		attributes map[string]string,
+	) ([]int64, error)
 	DeleteDocuments(ctx context.Context, collectionName, fileID string) error
-	Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error)
+	Search(
+		ctx context.Context,
+		collectionName string,
+		index milvus.IndexConfig,
+		vectors []float32,
+		query string,
+		numDocuments int,
+		filter *milvus.Filter,
+		mode milvus.SearchMode,
+	) ([]*milvus.SearchResult, error)
+}
+
+// chunkTextStore stores the full texts of chunks that are longer than the vector store allows.
+type chunkTextStore interface {
+	CreateChunkTexts(cts []*store.ChunkText) error
+	ListChunkTextsByChunkIDs(vectorStoreID string, chunkIDs []int64) ([]*store.ChunkText, error)
+	DeleteAllChunkTextsByFileID(vectorStoreID, fileID string) error
 }
 
 // E is an embedder.
@@ -39,25 +101,59 @@ type E struct {
 	llmClient    LLMClient
 	s3Client     s3Client
 	vstoreClient vstoreClient
-	log          logr.Logger
+	textStore    chunkTextStore
+	// reranker is nil if reranking is not enabled.
+	reranker Reranker
+	// tokenizers is keyed by model name.
+	tokenizers map[string]Tokenizer
+	// contextLengths is keyed by model name.
+	contextLengths map[string]int
+
+	batchSize       int
+	concurrency     int
+	insertBatchSize int
+
+	log logr.Logger
 }
 
-// New creates a new Embedder.
+// New creates a new Embedder. The texts of chunks that are too long for the vector store are kept in textStore.
+// The reranker can be nil if reranking is not enabled. The tokenizers are keyed by model name. The chunk sizes of
+// models without a tokenizer are estimated from the number of characters.
 func New(
 	llmClient LLMClient,
 	s3Client s3Client,
 	vstoreClient vstoreClient,
+	textStore chunkTextStore,
+	reranker Reranker,
+	tokenizers map[string]Tokenizer,
+	cfg config.EmbeddingConfig,
 	log logr.Logger,
 ) *E {
 	return &E{
-		llmClient:    llmClient,
-		s3Client:     s3Client,
-		vstoreClient: vstoreClient,
-		log:          log.WithName("embed"),
+		llmClient:       llmClient,
+		s3Client:        s3Client,
+		vstoreClient:    vstoreClient,
+		textStore:       textStore,
+		reranker:        reranker,
+		tokenizers:      tokenizers,
+		contextLengths:  cfg.ContextLengths,
+		batchSize:       cfg.BatchSize,
+		concurrency:     cfg.Concurrency,
+		insertBatchSize: cfg.InsertBatchSize,
+		log:             log.WithName("embed"),
 	}
 }
 
-// AddFile adds a file to the embedder.
+// FileResult is the result of adding a file.
+type FileResult struct {
+	// Chunking is the chunking strategy used to split the file, which includes the method and sizes chosen by the
+	// auto chunking strategy.
+	Chunking Chunking
+	// UsageBytes is the number of bytes used by the chunks of the file in the vector store.
+	UsageBytes int64
+}
+
+// AddFile adds a file to the embedder. The attributes are attached to every chunk of the file.
 func (e *E) AddFile(
 	ctx context.Context,
 	collectionName,
@@ -65,13 +161,13 @@ func (e *E) AddFile(
 	fileID,
 	fileName,
 	filePath string,
-	chunkSizeTokens,
-	chunkOverlapTokens int64,
-) error {
+	chunking Chunking,
+	attributes map[string]string,
+) (FileResult, error) {
 	e.log.Info("Downloading file", "from", filePath)
 	f, err := os.CreateTemp("/tmp", "rag-file-")
 	if err != nil {
-		return err
+		return FileResult{}, err
 	}
 
 	log := e.log.WithValues("file", f.Name())
@@ -82,89 +178,408 @@ func (e *E) AddFile(
 	}()
 
 	if err := e.s3Client.Download(ctx, f, filePath); err != nil {
-		return fmt.Errorf("download: %s", err)
+		return FileResult{}, fmt.Errorf("download: %s", err)
 	}
 	log.Info("Downloaded file")
 	if err := f.Close(); err != nil {
-		return err
+		return FileResult{}, err
+	}
+
+	contextLength, ok := e.contextLengths[modelName]
+	if !ok {
+		contextLength = defaultContextLengthTokens
+	}
+	// Pull the model first as the semantic chunking strategy embeds sentences to split the file.
+	if err := e.llmClient.PullModel(ctx, modelName); err != nil {
+		return FileResult{}, fmt.Errorf("pull model: %w", err)
 	}
 
-	docs, err := splitFile(logr.NewContext(ctx, log), f.Name(), filepath.Ext(fileName), chunkSizeTokens, chunkSizeTokens)
+	embed := func(ctx context.Context, texts []string) ([][]float32, error) {
+		return e.embedTexts(ctx, modelName, texts)
+	}
+	maxChunks := chunking.MaxChunks
+	docs, chunking, err := splitFile(logr.NewContext(ctx, log), f.Name(), fileName, chunking, contextLength, e.tokenizers[modelName], embed)
 	if err != nil {
-		return fmt.Errorf("split file: %s", err)
+		return FileResult{}, fmt.Errorf("split file: %w", err)
 	}
 	log.Info("Splitted file into chunks", "count", len(docs))
-
-	if err := e.llmClient.PullModel(ctx, modelName); err != nil {
-		return fmt.Errorf("pull model: %s", err)
+	if maxChunks > 0 && len(docs) > maxChunks {
+		// Fail before embedding the chunks.
+		return FileResult{}, fmt.Errorf("the file is split into %d chunks, exceeding the quota of %d chunks per file", len(docs), maxChunks)
 	}
 
-	var embeddings [][]float32
 	var texts []string
-	var files []string
+	var metadata []milvus.ChunkMetadata
 	for _, doc := range docs {
-		es, err := e.llmClient.Embed(ctx, modelName, doc.PageContent)
+		texts = append(texts, doc.PageContent)
+		metadata = append(metadata, toChunkMetadata(doc))
+	}
+	usage, err := e.embedAndInsert(ctx, collectionName, modelName, fileID, texts, metadata, attributes)
+	if err != nil {
+		// Remove the chunks that have already been inserted so that a failed file does not match any search.
+		if derr := e.DeleteFile(context.WithoutCancel(ctx), collectionName, fileID); derr != nil {
+			log.Error(derr, "Failed to delete the inserted chunks")
+		}
+		return FileResult{}, err
+	}
+	return FileResult{Chunking: chunking, UsageBytes: usage}, nil
+}
+
+type embeddedBatch struct {
+	texts      []string
+	metadata   []milvus.ChunkMetadata
+	embeddings [][]float32
+}
+
+// embedAndInsert embeds texts and inserts them into the vector store in batches of e.insertBatchSize. The next batch is
+// embedded while the current batch is inserted, so at most a few batches of embeddings are held in memory. metadata has
+// the same order as texts. It returns the number of bytes used by the inserted chunks.
+func (e *E) embedAndInsert(
+	ctx context.Context,
+	collectionName,
+	modelName,
+	fileID string,
+	texts []string,
+	metadata []milvus.ChunkMetadata,
+	attributes map[string]string,
+) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	batches := make(chan *embeddedBatch, 1)
+	var embedErr error
+	go func() {
+		defer close(batches)
+		for start := 0; start < len(texts); start += e.insertBatchSize {
+			end := min(start+e.insertBatchSize, len(texts))
+			es, err := e.embedTexts(ctx, modelName, texts[start:end])
+			if err != nil {
+				embedErr = fmt.Errorf("llm embed: %w", err)
+				return
+			}
+			select {
+			case batches <- &embeddedBatch{texts: texts[start:end], metadata: metadata[start:end], embeddings: es}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var usage int64
+	var insertErr error
+	for b := range batches {
+		u, err := e.insert(ctx, collectionName, fileID, b, attributes)
 		if err != nil {
-			return fmt.Errorf("llm embed: %s", err)
+			insertErr = err
+			cancel()
+			break
 		}
-		embeddings = append(embeddings, es)
-		texts = append(texts, doc.PageContent)
-		files = append(files, fileID)
+		usage += u
+	}
+	// Wait for the embedding goroutine to stop. embedErr is safe to read once the channel is closed.
+	for range batches {
 	}
-	return e.vstoreClient.InsertDocuments(ctx, collectionName, files, texts, embeddings)
+
+	if insertErr != nil {
+		return 0, insertErr
+	}
+	if embedErr != nil {
+		return 0, embedErr
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return usage, nil
 }
 
-func splitFile(ctx context.Context, fileName, fileType string, chunkSizeTokens, chunkOverlapTokens int64) ([]schema.Document, error) {
-	logr.FromContextOrDiscard(ctx).Info("Splitting file into chunks")
-	file, err := os.Open(fileName)
+// insert inserts a batch of embedded texts into the vector store and returns the number of bytes used by the batch.
+// The vector store holds a prefix of a text longer than milvus.MaxTextBytes, and the full text is stored in the text
+// store keyed by the chunk ID.
+func (e *E) insert(ctx context.Context, collectionName, fileID string, b *embeddedBatch, attributes map[string]string) (int64, error) {
+	files := make([]string, len(b.texts))
+	var overflows []int
+	for i, text := range b.texts {
+		files[i] = fileID
+		if len(text) > milvus.MaxTextBytes {
+			overflows = append(overflows, i)
+		}
+	}
+	usage, err := batchUsageBytes(fileID, b, attributes)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := e.vstoreClient.InsertDocuments(ctx, collectionName, files, b.texts, b.metadata, b.embeddings, attributes)
 	if err != nil {
+		return 0, fmt.Errorf("insert documents: %s", err)
+	}
+	if len(overflows) == 0 {
+		return usage, nil
+	}
+	if len(ids) != len(b.texts) {
+		return 0, fmt.Errorf("got %d chunk IDs for %d documents", len(ids), len(b.texts))
+	}
+	var cts []*store.ChunkText
+	for _, i := range overflows {
+		cts = append(cts, &store.ChunkText{
+			VectorStoreID: collectionName,
+			FileID:        fileID,
+			ChunkID:       ids[i],
+			Text:          b.texts[i],
+		})
+	}
+	if err := e.textStore.CreateChunkTexts(cts); err != nil {
+		return 0, fmt.Errorf("create chunk texts: %s", err)
+	}
+	return usage, nil
+}
+
+// batchUsageBytes returns the number of bytes used by a batch of chunks. Each chunk uses the bytes of its full text,
+// its embedding, and the metadata stored with it, i.e., the file ID, the attributes, the chunk metadata, and the
+// primary key.
+func batchUsageBytes(fileID string, b *embeddedBatch, attributes map[string]string) (int64, error) {
+	var attrBytes int
+	for k, v := range attributes {
+		attrBytes += len(k) + len(v)
+	}
+	var usage int64
+	for i, text := range b.texts {
+		md, err := json.Marshal(b.metadata[i])
+		if err != nil {
+			return 0, fmt.Errorf("marshal metadata: %s", err)
+		}
+		usage += int64(len(text) + len(b.embeddings[i])*bytesPerDimension + len(fileID) + attrBytes + len(md) + primaryKeyBytes)
+	}
+	return usage, nil
+}
+
+// embedTexts embeds texts in batches. Up to e.concurrency batches are embedded concurrently. The embeddings are
+// returned in the same order as the texts.
+func (e *E) embedTexts(ctx context.Context, modelName string, texts []string) ([][]float32, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	embeddings := make([][]float32, len(texts))
+	sem := make(chan struct{}, e.concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+loop:
+	for start := 0; start < len(texts); start += e.batchSize {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		end := min(start+e.batchSize, len(texts))
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			es, err := e.llmClient.EmbedBatch(ctx, modelName, texts[start:end])
+			if err == nil && len(es) != end-start {
+				err = fmt.Errorf("got %d embeddings for %d texts", len(es), end-start)
+			}
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = err
+					// Stop embedding the remaining batches.
+					cancel()
+				}
+				return
+			}
+			copy(embeddings[start:end], es)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	defer func() {
-		_ = file.Close()
-	}()
+	return embeddings, nil
+}
 
-	splitter := textsplitter.NewRecursiveCharacter()
-	splitter.ChunkSize = int(chunkSizeTokens) * charactersPerToken
-	splitter.ChunkOverlap = int(chunkOverlapTokens) * charactersPerToken
+// splitFile splits a file into chunks. The type of the file is detected from its content and name. The chunk sizes
+// are measured with the tokenizer, or estimated from the number of characters if the tokenizer is nil. For the auto
+// chunking strategy, the method and sizes are chosen from the file type and the context length of the model. It
+// returns the chunking strategy used to split the file. The semantic chunking strategy embeds sentences with embed.
+func splitFile(
+	ctx context.Context,
+	path,
+	fileName string,
+	chunking Chunking,
+	contextLength int,
+	tokenizer Tokenizer,
+	embed embedFunc,
+) ([]schema.Document, Chunking, error) {
+	ftype, err := detectFileType(path, fileName)
+	if err != nil {
+		return nil, Chunking{}, err
+	}
+	if chunking.Auto {
+		chunking = autoChunking(ftype, contextLength)
+	}
+	logr.FromContextOrDiscard(ctx).Info(
+		"Splitting file into chunks",
+		"fileType", ftype,
+		"method", chunking.Method,
+		"maxChunkSizeTokens", chunking.MaxChunkSizeTokens,
+		"chunkOverlapTokens", chunking.ChunkOverlapTokens,
+		"tokenizer", tokenizer != nil,
+	)
 
-	switch fileType {
-	case ".pdf":
-		finfo, err := file.Stat()
-		if err != nil {
-			return nil, err
+	chunkSize, chunkOverlap := int(chunking.MaxChunkSizeTokens), int(chunking.ChunkOverlapTokens)
+	lenFunc := utf8.RuneCountInString
+	if tokenizer != nil {
+		lenFunc = tokenizer.CountTokens
+	} else {
+		chunkSize *= charactersPerToken
+		chunkOverlap *= charactersPerToken
+	}
+	opts := splitOptions{
+		method:       chunking.Method,
+		chunkSize:    chunkSize,
+		chunkOverlap: chunkOverlap,
+		lenFunc:      lenFunc,
+	}
+	if sc := chunking.Semantic; sc != nil {
+		opts.semantic = &semanticSplitter{
+			ctx:                  ctx,
+			embed:                embed,
+			breakpointPercentile: sc.BreakpointPercentile,
+			bufferSize:           sc.BufferSize,
+			sentences: &sentenceSplitter{
+				chunkSize: chunkSize,
+				lenFunc:   lenFunc,
+				long: textsplitter.NewRecursiveCharacter(
+					textsplitter.WithChunkSize(chunkSize),
+					textsplitter.WithChunkOverlap(0),
+					textsplitter.WithLenFunc(lenFunc),
+				),
+			},
 		}
-		return documentloaders.NewPDF(file, finfo.Size()).LoadAndSplit(ctx, splitter)
-	case ".html":
-		return documentloaders.NewHTML(file).LoadAndSplit(ctx, splitter)
-	case ".txt":
-		return documentloaders.NewText(file).LoadAndSplit(ctx, splitter)
-	// TODO(guangrui): support more file types.
-	default:
-		return nil, fmt.Errorf("unexpected file type: fileType=%q", fileType)
 	}
+	docs, err := loadAndSplit(ctx, path, ftype, fileName, opts)
+	if err != nil {
+		return nil, Chunking{}, err
+	}
+	return docs, chunking, nil
 }
 
 // DeleteFile deletes a file from the embedder.
 func (e *E) DeleteFile(ctx context.Context, collectionName, fileID string) error {
-	return e.vstoreClient.DeleteDocuments(ctx, collectionName, fileID)
+	if err := e.vstoreClient.DeleteDocuments(ctx, collectionName, fileID); err != nil {
+		return err
+	}
+	if err := e.textStore.DeleteAllChunkTextsByFileID(collectionName, fileID); err != nil {
+		return fmt.Errorf("delete chunk texts: %s", err)
+	}
+	return nil
 }
 
-// Search searches for the matched documents in the embedder for the given query.
-func (e *E) Search(ctx context.Context, collectionName, modelName, query string, numDocs int) ([]string, error) {
-	if err := e.llmClient.PullModel(ctx, modelName); err != nil {
-		return nil, fmt.Errorf("pull model: %s", err)
+// Search searches for the matched documents in the embedder for the given query. The index configuration must match
+// the one of the collection. If filter is not nil, only documents whose attributes match the filter are returned.
+// The query is not embedded for sparse search. If rerank is true, more candidates are retrieved and the top numDocs
+// documents are returned in the order of the reranker scores.
+func (e *E) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	modelName, query string,
+	numDocs int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+	rerank bool,
+) ([]*milvus.SearchResult, error) {
+	numCandidates := numDocs
+	if rerank {
+		if e.reranker == nil {
+			return nil, ErrRerankerNotConfigured
+		}
+		numCandidates = numDocs * rerankCandidateMultiplier
 	}
 
-	es, err := e.llmClient.Embed(ctx, modelName, query)
-	if err != nil {
-		return nil, fmt.Errorf("embed: %s", err)
+	var es []float32
+	if mode != milvus.SearchModeSparse {
+		if err := e.llmClient.PullModel(ctx, modelName); err != nil {
+			return nil, fmt.Errorf("pull model: %w", err)
+		}
+
+		var err error
+		es, err = e.llmClient.Embed(ctx, modelName, query)
+		if err != nil {
+			return nil, fmt.Errorf("embed: %w", err)
+		}
 	}
 
-	results, err := e.vstoreClient.Search(ctx, collectionName, es, numDocs)
+	results, err := e.vstoreClient.Search(ctx, collectionName, index, es, query, numCandidates, filter, mode)
 	if err != nil {
 		return nil, fmt.Errorf("vector search: %s", err)
 	}
-	e.log.Info("search result", "query", query, "results", results)
+	if err := e.fillFullTexts(collectionName, results); err != nil {
+		return nil, err
+	}
+	if rerank {
+		if results, err = e.rerank(ctx, query, results, numDocs); err != nil {
+			return nil, err
+		}
+	}
+	e.log.Info("search result", "query", query, "mode", mode, "rerank", rerank, "numResults", len(results))
+	return results, nil
+}
+
+// fillFullTexts replaces the texts of the results that are truncated in the vector store with their full texts.
+func (e *E) fillFullTexts(collectionName string, results []*milvus.SearchResult) error {
+	var ids []int64
+	for _, r := range results {
+		ids = append(ids, r.ChunkID)
+	}
+	cts, err := e.textStore.ListChunkTextsByChunkIDs(collectionName, ids)
+	if err != nil {
+		return fmt.Errorf("list chunk texts: %s", err)
+	}
+	texts := map[int64]string{}
+	for _, ct := range cts {
+		texts[ct.ChunkID] = ct.Text
+	}
+	for _, r := range results {
+		if t, ok := texts[r.ChunkID]; ok {
+			r.Text = t
+		}
+	}
+	return nil
+}
+
+// rerank re-sorts the results by the reranker scores and returns the top numDocs results.
+func (e *E) rerank(ctx context.Context, query string, results []*milvus.SearchResult, numDocs int) ([]*milvus.SearchResult, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+	var docs []string
+	for _, r := range results {
+		docs = append(docs, r.Text)
+	}
+	scores, err := e.reranker.Rerank(ctx, query, docs)
+	if err != nil {
+		return nil, fmt.Errorf("rerank: %w", err)
+	}
+	if len(scores) != len(results) {
+		return nil, fmt.Errorf("rerank: got %d scores for %d documents", len(scores), len(results))
+	}
+	for i, r := range results {
+		r.Score = scores[i]
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > numDocs {
+		results = results[:numDocs]
+	}
 	return results, nil
 }
@@ -4,13 +4,27 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/tmc/langchaingo/schema"
 )
 
+var testEmbeddingConfig = config.EmbeddingConfig{
+	BatchSize:       2,
+	Concurrency:     2,
+	InsertBatchSize: 4,
+}
+
 func TestAddSearchDeleteFile(t *testing.T) {
 	const (
 		fileID             = "file-001"
@@ -55,20 +69,31 @@ func TestAddSearchDeleteFile(t *testing.T) {
 						2: {"line2"},
 					},
 				},
+				&fakeChunkTextStore{},
+				nil,
+				nil,
+				testEmbeddingConfig,
 				testr.New(t),
 			)
 			ctx := context.Background()
-			err := e.AddFile(ctx, collectionName0, modelName, fileID, tc.fileName, tc.path, chunkSizeTokens, chunkOverlapTokens)
+			chunking := Chunking{MaxChunkSizeTokens: chunkSizeTokens, ChunkOverlapTokens: chunkOverlapTokens}
+			_, err := e.AddFile(ctx, collectionName0, modelName, fileID, tc.fileName, tc.path, chunking, nil)
 			if tc.wantErr {
 				assert.Error(t, err)
 				return
 			}
 			assert.NoError(t, err)
 
-			docs, err := e.Search(ctx, collectionName0, modelName, "line1", 1)
+			docs, err := e.Search(ctx, collectionName0, milvus.IndexConfig{}, modelName, "line1", 1, nil, milvus.SearchModeDense, false)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(docs))
+			assert.Equal(t, "line1", docs[0].Text)
+
+			// Sparse search does not embed the query.
+			docs, err = e.Search(ctx, collectionName0, milvus.IndexConfig{}, modelName, "line2", 1, nil, milvus.SearchModeSparse, false)
 			assert.NoError(t, err)
 			assert.Equal(t, 1, len(docs))
-			assert.Equal(t, "line1", docs[0])
+			assert.Equal(t, "line2", docs[0].Text)
 
 			err = e.DeleteFile(ctx, collectionName0, fileID)
 			assert.NoError(t, err)
@@ -76,6 +101,198 @@ func TestAddSearchDeleteFile(t *testing.T) {
 	}
 }
 
+func TestSearch_Rerank(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+	vs := &noopVStoreClient{
+		collectionName: collectionName,
+		docs: map[int][]string{
+			1: {"a", "ccc", "bb"},
+		},
+	}
+	llm := &noopLLMClient{
+		e: map[string][]float32{
+			"query": {1},
+		},
+	}
+	ctx := context.Background()
+
+	e := New(llm, &noopS3Client{}, vs, &fakeChunkTextStore{}, &fakeReranker{}, nil, testEmbeddingConfig, testr.New(t))
+	got, err := e.Search(ctx, collectionName, milvus.IndexConfig{}, modelName, "query", 2, nil, milvus.SearchModeDense, true)
+	assert.NoError(t, err)
+	// Candidates are over-fetched.
+	assert.Equal(t, 2*rerankCandidateMultiplier, vs.numDocuments)
+	var texts []string
+	var scores []float32
+	for _, r := range got {
+		texts = append(texts, r.Text)
+		scores = append(scores, r.Score)
+	}
+	assert.Equal(t, []string{"ccc", "bb"}, texts)
+	assert.Equal(t, []float32{3, 2}, scores)
+
+	// Reranking is not available without a reranker.
+	e = New(llm, &noopS3Client{}, vs, &fakeChunkTextStore{}, nil, nil, testEmbeddingConfig, testr.New(t))
+	_, err = e.Search(ctx, collectionName, milvus.IndexConfig{}, modelName, "query", 2, nil, milvus.SearchModeDense, true)
+	assert.ErrorIs(t, err, ErrRerankerNotConfigured)
+}
+
+func TestEmbedTexts(t *testing.T) {
+	tcs := []struct {
+		name        string
+		texts       []string
+		batchSize   int
+		wantBatches int
+		wantErr     bool
+	}{
+		{
+			name:        "multiple batches",
+			texts:       []string{"a", "bb", "ccc", "dddd", "eeeee"},
+			batchSize:   2,
+			wantBatches: 3,
+		},
+		{
+			name:        "single batch",
+			texts:       []string{"a", "bb"},
+			batchSize:   10,
+			wantBatches: 1,
+		},
+		{
+			name:      "error",
+			texts:     []string{"a", "error", "ccc"},
+			batchSize: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			llm := &batchLLMClient{}
+			e := New(llm, &noopS3Client{}, &noopVStoreClient{}, &fakeChunkTextStore{}, nil, nil, config.EmbeddingConfig{
+				BatchSize:   tc.batchSize,
+				Concurrency: 2,
+			}, testr.New(t))
+			got, err := e.embedTexts(context.Background(), "model", tc.texts)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantBatches, llm.numBatches)
+			// The embeddings are in the order of the texts.
+			assert.Equal(t, len(tc.texts), len(got))
+			for i, text := range tc.texts {
+				assert.Equal(t, []float32{float32(len(text))}, got[i])
+			}
+		})
+	}
+}
+
+func TestAddFile_InsertBatches(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		fileID         = "file0"
+	)
+	tcs := []struct {
+		name string
+		// failInsertAt is the 1-based index of the insert call that fails. No insert fails if 0.
+		failInsertAt int
+		wantErr      bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:         "second batch fails",
+			failInsertAt: 2,
+			wantErr:      true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			vs := &recordingVStoreClient{failInsertAt: tc.failInsertAt}
+			e := New(&batchLLMClient{}, &fileS3Client{}, vs, &fakeChunkTextStore{}, nil, nil, config.EmbeddingConfig{
+				BatchSize:       2,
+				Concurrency:     2,
+				InsertBatchSize: 3,
+			}, testr.New(t))
+			chunking := Chunking{MaxChunkSizeTokens: 10, ChunkOverlapTokens: 2}
+			_, err := e.AddFile(context.Background(), collectionName, "model", fileID, "test.txt", "testdata/test.txt", chunking, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				// The inserted chunks are deleted.
+				assert.Equal(t, []string{fileID}, vs.deleted)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, vs.deleted)
+
+			// The chunks are inserted in batches.
+			assert.Greater(t, len(vs.inserted), 1)
+			var n int
+			for i, texts := range vs.inserted {
+				if i < len(vs.inserted)-1 {
+					assert.Len(t, texts, 3)
+				} else {
+					assert.LessOrEqual(t, len(texts), 3)
+				}
+				n += len(texts)
+			}
+			// Each chunk is inserted with its metadata.
+			assert.Len(t, vs.metadata, n)
+			for i, m := range vs.metadata {
+				assert.Equal(t, i, m.ChunkIndex)
+			}
+		})
+	}
+}
+
+func TestAddFile_MaxChunks(t *testing.T) {
+	vs := &recordingVStoreClient{}
+	e := New(&batchLLMClient{}, &fileS3Client{}, vs, &fakeChunkTextStore{}, nil, nil, testEmbeddingConfig, testr.New(t))
+	chunking := Chunking{MaxChunkSizeTokens: 10, ChunkOverlapTokens: 2, MaxChunks: 1}
+	_, err := e.AddFile(context.Background(), "collection0", "model", "file0", "test.txt", "testdata/test.txt", chunking, nil)
+	assert.ErrorContains(t, err, "exceeding the quota of 1 chunks per file")
+	// Nothing is inserted.
+	assert.Empty(t, vs.inserted)
+}
+
+func TestAddFile_LongText(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		fileID         = "file0"
+	)
+	// The chunk has fewer characters than the limit but more bytes.
+	text := strings.Repeat("あ", milvus.MaxTextBytes/2)
+	path := writeTempFile(t, []byte(text))
+
+	vs := &recordingVStoreClient{}
+	ts := &fakeChunkTextStore{}
+	e := New(&batchLLMClient{}, &fileS3Client{}, vs, ts, nil, nil, testEmbeddingConfig, testr.New(t))
+	chunking := Chunking{MaxChunkSizeTokens: 4096}
+	res, err := e.AddFile(context.Background(), collectionName, "model", fileID, "test.txt", path, chunking, nil)
+	assert.NoError(t, err)
+	// The usage includes the full text.
+	assert.Greater(t, res.UsageBytes, int64(len(text)))
+
+	// The full text is passed to the vector store, which holds a prefix of the text, and stored in the text store.
+	assert.Len(t, vs.inserted, 1)
+	assert.Equal(t, []string{text}, vs.inserted[0])
+	assert.Len(t, ts.texts, 1)
+	assert.Equal(t, text, ts.texts[0].Text)
+
+	// Search results have the full text.
+	results := []*milvus.SearchResult{{ChunkID: ts.texts[0].ChunkID, Text: "あ"}}
+	err = e.fillFullTexts(collectionName, results)
+	assert.NoError(t, err)
+	assert.Equal(t, text, results[0].Text)
+
+	err = e.DeleteFile(context.Background(), collectionName, fileID)
+	assert.NoError(t, err)
+	assert.Empty(t, ts.texts)
+}
+
 func TestSplitFile(t *testing.T) {
 	tcs := []struct {
 		name               string
@@ -105,7 +322,8 @@ func TestSplitFile(t *testing.T) {
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
-			got, err := splitFile(ctx, tc.path, ".txt", tc.chunkSizeTokens, tc.chunkOverlapTokens)
+			chunking := Chunking{MaxChunkSizeTokens: tc.chunkSizeTokens, ChunkOverlapTokens: tc.chunkOverlapTokens}
+			got, _, err := splitFile(ctx, tc.path, filepath.Base(tc.path), chunking, defaultContextLengthTokens, nil, nil)
 			if tc.wantErr {
 				assert.Error(t, err)
 				return
@@ -132,6 +350,18 @@ func (c *noopLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]
 	return e, nil
 }
 
+func (c *noopLLMClient) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	var es [][]float32
+	for _, p := range prompts {
+		e, err := c.Embed(ctx, modelName, p)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
 func (c *noopLLMClient) PullModel(ctx context.Context, modelName string) error {
 	return nil
 }
@@ -144,21 +374,82 @@ func (n *noopS3Client) Download(ctx context.Context, w io.WriterAt, key string)
 	return nil
 }
 
+// fileS3Client reads objects from local files.
+type fileS3Client struct{}
+
+func (c *fileS3Client) Download(ctx context.Context, w io.WriterAt, key string) error {
+	b, err := os.ReadFile(key)
+	if err != nil {
+		return err
+	}
+	_, err = w.WriteAt(b, 0)
+	return err
+}
+
+type recordingVStoreClient struct {
+	failInsertAt int
+
+	inserted [][]string
+	metadata []milvus.ChunkMetadata
+	deleted  []string
+}
+
+func (c *recordingVStoreClient) InsertDocuments(
+	ctx context.Context,
+	collectionName string,
+	fileIDs, texts []string,
+	metadata []milvus.ChunkMetadata,
+	vectors [][]float32,
+	attributes map[string]string,
+) ([]int64, error) {
+	if len(c.inserted)+1 == c.failInsertAt {
+		return nil, fmt.Errorf("insert error")
+	}
+	var ids []int64
+	for i := range texts {
+		ids = append(ids, int64(len(c.metadata)+i))
+	}
+	c.inserted = append(c.inserted, texts)
+	c.metadata = append(c.metadata, metadata...)
+	return ids, nil
+}
+
+func (c *recordingVStoreClient) DeleteDocuments(ctx context.Context, collectionName, fileID string) error {
+	c.deleted = append(c.deleted, fileID)
+	return nil
+}
+
+func (c *recordingVStoreClient) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+) ([]*milvus.SearchResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 type noopVStoreClient struct {
 	collectionName string
 	docs           map[int][]string
+	numDocuments   int
 }
 
 func (c *noopVStoreClient) InsertDocuments(
 	ctx context.Context,
 	collectionName string,
 	fileIDs, texts []string,
+	metadata []milvus.ChunkMetadata,
 	vectors [][]float32,
-) error {
+	attributes map[string]string,
+) ([]int64, error) {
 	if collectionName != c.collectionName {
-		return fmt.Errorf("collection %s not found", collectionName)
+		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return nil
+	return make([]int64, len(texts)), nil
 }
 
 func (c *noopVStoreClient) DeleteDocuments(ctx context.Context, collectionName, fileID string) error {
@@ -168,9 +459,112 @@ func (c *noopVStoreClient) DeleteDocuments(ctx context.Context, collectionName,
 	return nil
 }
 
-func (c *noopVStoreClient) Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error) {
+func (c *noopVStoreClient) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+) ([]*milvus.SearchResult, error) {
 	if collectionName != c.collectionName {
 		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return c.docs[int(vectors[0])], nil
+	c.numDocuments = numDocuments
+	if mode == milvus.SearchModeSparse {
+		if vectors != nil {
+			return nil, fmt.Errorf("unexpected vectors for sparse search")
+		}
+		return []*milvus.SearchResult{{Text: query}}, nil
+	}
+	var results []*milvus.SearchResult
+	for i, text := range c.docs[int(vectors[0])] {
+		results = append(results, &milvus.SearchResult{
+			ChunkID: int64(i),
+			Text:    text,
+		})
+	}
+	return results, nil
+}
+
+type fakeChunkTextStore struct {
+	texts []*store.ChunkText
+}
+
+func (s *fakeChunkTextStore) CreateChunkTexts(cts []*store.ChunkText) error {
+	s.texts = append(s.texts, cts...)
+	return nil
+}
+
+func (s *fakeChunkTextStore) ListChunkTextsByChunkIDs(vectorStoreID string, chunkIDs []int64) ([]*store.ChunkText, error) {
+	var cts []*store.ChunkText
+	for _, ct := range s.texts {
+		if ct.VectorStoreID == vectorStoreID && slices.Contains(chunkIDs, ct.ChunkID) {
+			cts = append(cts, ct)
+		}
+	}
+	return cts, nil
+}
+
+func (s *fakeChunkTextStore) DeleteAllChunkTextsByFileID(vectorStoreID, fileID string) error {
+	s.texts = slices.DeleteFunc(s.texts, func(ct *store.ChunkText) bool {
+		return ct.VectorStoreID == vectorStoreID && ct.FileID == fileID
+	})
+	return nil
+}
+
+// fakeReranker scores documents by their length.
+type fakeReranker struct{}
+
+func (r *fakeReranker) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	var scores []float32
+	for _, d := range documents {
+		scores = append(scores, float32(len(d)))
+	}
+	return scores, nil
+}
+
+// batchLLMClient embeds a prompt into a vector of its length.
+type batchLLMClient struct {
+	mu         sync.Mutex
+	numBatches int
+}
+
+func (c *batchLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *batchLLMClient) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	c.mu.Lock()
+	c.numBatches++
+	c.mu.Unlock()
+
+	var es [][]float32
+	for _, p := range prompts {
+		if p == "error" {
+			return nil, fmt.Errorf("embed error")
+		}
+		es = append(es, []float32{float32(len(p))})
+	}
+	return es, nil
+}
+
+func (c *batchLLMClient) PullModel(ctx context.Context, modelName string) error {
+	return nil
+}
+
+func TestBatchUsageBytes(t *testing.T) {
+	b := &embeddedBatch{
+		texts:      []string{"hello", "こんにちは"},
+		metadata:   []milvus.ChunkMetadata{{ChunkIndex: 0}, {ChunkIndex: 1}},
+		embeddings: [][]float32{{0.1, 0.2, 0.3}, {0.4, 0.5, 0.6}},
+	}
+	got, err := batchUsageBytes("file0", b, map[string]string{"key": "value"})
+	assert.NoError(t, err)
+	// Each chunk has the text, 3 dimensions of 4 bytes, the file ID, the attribute, the metadata ({"chunkIndex":N}),
+	// and the primary key.
+	want := int64((5 + 12 + 5 + 8 + 16 + 8) + (15 + 12 + 5 + 8 + 16 + 8))
+	assert.Equal(t, want, got)
 }
@@ -0,0 +1,533 @@
+package embedder
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// fileType is the type of a file that determines how the file is loaded and split.
+type fileType string
+
+const (
+	fileTypePDF      fileType = "pdf"
+	fileTypeHTML     fileType = "html"
+	fileTypeText     fileType = "text"
+	fileTypeMarkdown fileType = "markdown"
+	fileTypeDOCX     fileType = "docx"
+	fileTypePPTX     fileType = "pptx"
+	fileTypeCSV      fileType = "csv"
+	fileTypeJSON     fileType = "json"
+	fileTypeJSONL    fileType = "jsonl"
+	fileTypeCode     fileType = "code"
+)
+
+// sniffLen is the number of bytes used to detect the content type.
+const sniffLen = 512
+
+var (
+	textFileTypesByExt = map[string]fileType{
+		".txt":      fileTypeText,
+		".md":       fileTypeMarkdown,
+		".markdown": fileTypeMarkdown,
+		".csv":      fileTypeCSV,
+		".json":     fileTypeJSON,
+		".jsonl":    fileTypeJSONL,
+		".ndjson":   fileTypeJSONL,
+		// Configuration files are split like plain text as they do not have declarations.
+		".yaml": fileTypeText,
+		".yml":  fileTypeText,
+		".toml": fileTypeText,
+		".ini":  fileTypeText,
+	}
+
+	// codeSeparators are the separators used to split source code, keyed by file extension. The separators are
+	// the keywords that start declarations at the top level or in classes, and are tried in order so that a file is
+	// split at top-level declarations first, then at methods. Declarations without such keywords (e.g., C functions
+	// returning user-defined types) are split at blank lines.
+	codeSeparators = map[string][]string{}
+
+	pptxSlideRe = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+
+	spacesRe     = regexp.MustCompile(`\s+`)
+	blankLinesRe = regexp.MustCompile(`[ \t]*\n[ \t]*(\n[ \t]*)+`)
+)
+
+func init() {
+	generic := []string{"\n\n", "\n", " ", ""}
+	for exts, seps := range map[string][]string{
+		".go": {"\nfunc ", "\ntype ", "\nvar ", "\nconst "},
+		".py": {"\nclass ", "\ndef ", "\nasync def ", "\n    def ", "\n    async def "},
+		".js .jsx .mjs .ts .tsx": {
+			"\nexport ", "\nfunction ", "\nasync function ", "\nclass ", "\ninterface ", "\ntype ", "\nconst ", "\nlet ",
+		},
+		".java .cs": {
+			"\npublic ", "\nclass ", "\ninterface ", "\nenum ", "\nrecord ",
+			"\n    public ", "\n    protected ", "\n    private ", "\n    static ", "\n    internal ",
+		},
+		".kt":    {"\nclass ", "\ndata class ", "\nobject ", "\ninterface ", "\nfun ", "\n    fun ", "\n    override fun "},
+		".scala": {"\nclass ", "\ncase class ", "\nobject ", "\ntrait ", "\ndef ", "\n  def ", "\n  override def "},
+		".c .h": {
+			"\nstruct ", "\nenum ", "\nunion ", "\ntypedef ", "\nstatic ", "\nextern ",
+			"\nvoid ", "\nint ", "\nchar ", "\nbool ", "\nunsigned ", "\nlong ", "\ndouble ", "\nconst ",
+		},
+		".cc .cpp .hpp": {
+			"\nnamespace ", "\ntemplate ", "\nclass ", "\nstruct ", "\nenum ", "\ntypedef ", "\nstatic ",
+			"\nvoid ", "\nint ", "\nchar ", "\nbool ", "\nauto ", "\nstd::", "\nconst ",
+		},
+		".rs":       {"\nfn ", "\npub fn ", "\nimpl ", "\nstruct ", "\npub struct ", "\nenum ", "\npub enum ", "\ntrait ", "\nmod ", "\n    fn ", "\n    pub fn "},
+		".rb":       {"\nclass ", "\nmodule ", "\ndef ", "\n  def "},
+		".php":      {"\nfunction ", "\nclass ", "\ninterface ", "\ntrait ", "\n    public function ", "\n    protected function ", "\n    private function "},
+		".sh .bash": {"\nfunction "},
+		".sql":      {"\nCREATE ", "\nALTER ", "\nDROP ", "\nSELECT ", "\nINSERT ", "\nUPDATE ", "\nDELETE "},
+		".proto":    {"\nmessage ", "\nservice ", "\nenum ", "\n  rpc "},
+		".swift":    {"\nfunc ", "\nclass ", "\nstruct ", "\nenum ", "\nprotocol ", "\nextension ", "\n    func "},
+		".lua":      {"\nfunction ", "\nlocal function "},
+		".pl":       {"\nsub ", "\npackage "},
+		".dart":     {"\nclass ", "\nenum ", "\nmixin ", "\nextension ", "\nvoid "},
+		".groovy":   {"\nclass ", "\ninterface ", "\ndef ", "\n    def "},
+	} {
+		for _, ext := range strings.Fields(exts) {
+			codeSeparators[ext] = append(append([]string{}, seps...), generic...)
+		}
+	}
+}
+
+// detectFileType detects the type of a file. Binary formats are detected by sniffing the content. Text formats
+// that cannot be distinguished by content are detected from the extension of the file name.
+func detectFileType(path, fileName string) (fileType, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	head = head[:n]
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	ct := http.DetectContentType(head)
+	switch {
+	case ct == "application/pdf":
+		return fileTypePDF, nil
+	case ct == "application/zip":
+		return detectOfficeFileType(path)
+	case strings.HasPrefix(ct, "text/html"):
+		return fileTypeHTML, nil
+	case strings.HasPrefix(ct, "text/"):
+		if t, ok := textFileTypesByExt[ext]; ok {
+			return t, nil
+		}
+		if _, ok := codeSeparators[ext]; ok {
+			return fileTypeCode, nil
+		}
+		if ext == ".html" || ext == ".htm" {
+			return fileTypeHTML, nil
+		}
+		// Files without a known extension can still be JSON.
+		if t := bytes.TrimSpace(head); len(t) > 0 && (t[0] == '{' || t[0] == '[') {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return "", err
+			}
+			if json.Valid(b) {
+				return fileTypeJSON, nil
+			}
+		}
+		return fileTypeText, nil
+	default:
+		return "", fmt.Errorf("unsupported file: fileName=%q, contentType=%q", fileName, ct)
+	}
+}
+
+// detectOfficeFileType detects the type of an Office Open XML file, which is a zip archive.
+func detectOfficeFileType(path string) (fileType, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return "", fmt.Errorf("open zip: %s", err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	for _, f := range r.File {
+		switch f.Name {
+		case "word/document.xml":
+			return fileTypeDOCX, nil
+		case "ppt/presentation.xml":
+			return fileTypePPTX, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported zip archive")
+}
+
+// splitOptions are the options to split a file. The chunk size and overlap are measured with lenFunc.
+type splitOptions struct {
+	// method is empty unless it is chosen by the auto chunking strategy.
+	method       ChunkingMethod
+	chunkSize    int
+	chunkOverlap int
+	lenFunc      func(string) int
+	// semantic is set for the semantic chunking strategy. It splits all file types except records and source code.
+	semantic textsplitter.TextSplitter
+}
+
+// loadAndSplit loads a file of the given type and splits it into chunks with a splitter suited to the type.
+// The location of each chunk in the file is recorded in its metadata.
+func loadAndSplit(
+	ctx context.Context,
+	path string,
+	ftype fileType,
+	fileName string,
+	opts splitOptions,
+) ([]schema.Document, error) {
+	splitter := textsplitter.NewRecursiveCharacter(
+		textsplitter.WithChunkSize(opts.chunkSize),
+		textsplitter.WithChunkOverlap(opts.chunkOverlap),
+		textsplitter.WithLenFunc(opts.lenFunc),
+	)
+	// The Markdown splitter splits by headers first so that a chunk does not span sections. It checks the size of a
+	// section in characters, which is not smaller than the number of tokens. Long sections are split again with lenFunc.
+	var mdSplitter textsplitter.TextSplitter = textsplitter.NewMarkdownTextSplitter(
+		textsplitter.WithChunkSize(opts.chunkSize),
+		textsplitter.WithChunkOverlap(opts.chunkOverlap),
+		textsplitter.WithCodeBlocks(true),
+		textsplitter.WithSecondSplitter(textsplitter.NewRecursiveCharacter(
+			textsplitter.WithSeparators([]string{"\n\n", "\n", " "}),
+			textsplitter.WithChunkSize(opts.chunkSize),
+			textsplitter.WithChunkOverlap(opts.chunkOverlap),
+			textsplitter.WithLenFunc(opts.lenFunc),
+		)),
+	)
+	var textSplitter, proseSplitter textsplitter.TextSplitter = splitter, splitter
+	if opts.method == ChunkingMethodSentences {
+		proseSplitter = &sentenceSplitter{
+			chunkSize:    opts.chunkSize,
+			chunkOverlap: opts.chunkOverlap,
+			lenFunc:      opts.lenFunc,
+			long:         splitter,
+		}
+	}
+	if opts.semantic != nil {
+		textSplitter, proseSplitter, mdSplitter = opts.semantic, opts.semantic, opts.semantic
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var docs []schema.Document
+	var docSplitter textsplitter.TextSplitter
+	var markdown bool
+	switch ftype {
+	case fileTypePDF:
+		finfo, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		// Each page is a document.
+		docs, err = documentloaders.NewPDF(file, finfo.Size()).Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		docSplitter = textSplitter
+	case fileTypeHTML:
+		if opts.method != ChunkingMethodHeadings {
+			if docs, err = documentloaders.NewHTML(file).Load(ctx); err != nil {
+				return nil, err
+			}
+			docSplitter = textSplitter
+			break
+		}
+		text, err := htmlToMarkdown(file)
+		if err != nil {
+			return nil, err
+		}
+		docs = []schema.Document{{PageContent: text, Metadata: map[string]any{}}}
+		docSplitter, markdown = mdSplitter, true
+	case fileTypeText:
+		if docs, err = documentloaders.NewText(file).Load(ctx); err != nil {
+			return nil, err
+		}
+		docSplitter = proseSplitter
+	case fileTypeMarkdown:
+		if docs, err = documentloaders.NewText(file).Load(ctx); err != nil {
+			return nil, err
+		}
+		docSplitter, markdown = mdSplitter, true
+	case fileTypeCSV:
+		// Each row is a record.
+		if docs, err = documentloaders.NewCSV(file).Load(ctx); err != nil {
+			return nil, err
+		}
+		docSplitter = splitter
+	case fileTypeJSON:
+		if docs, err = loadJSON(file); err != nil {
+			return nil, err
+		}
+		docSplitter = splitter
+	case fileTypeJSONL:
+		if docs, err = loadJSONL(file); err != nil {
+			return nil, err
+		}
+		docSplitter = splitter
+	case fileTypeDOCX:
+		if docs, err = loadDOCX(path); err != nil {
+			return nil, err
+		}
+		docSplitter = proseSplitter
+	case fileTypePPTX:
+		// Each slide is a document.
+		if docs, err = loadPPTX(path); err != nil {
+			return nil, err
+		}
+		docSplitter = proseSplitter
+	case fileTypeCode:
+		if docs, err = documentloaders.NewText(file).Load(ctx); err != nil {
+			return nil, err
+		}
+		docSplitter = textsplitter.NewRecursiveCharacter(
+			textsplitter.WithSeparators(codeSeparators[strings.ToLower(filepath.Ext(fileName))]),
+			textsplitter.WithChunkSize(opts.chunkSize),
+			textsplitter.WithChunkOverlap(opts.chunkOverlap),
+			textsplitter.WithKeepSeparator(true),
+			textsplitter.WithLenFunc(opts.lenFunc),
+		)
+	default:
+		return nil, fmt.Errorf("unexpected file type: %q", ftype)
+	}
+	return splitDocuments(docSplitter, docs, markdown)
+}
+
+// loadJSON loads a JSON file. Each element of a top-level array is a record. Other values are loaded as a single document.
+func loadJSON(r io.Reader) ([]schema.Document, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(b, &elems); err != nil {
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("invalid json")
+		}
+		return []schema.Document{{PageContent: string(b), Metadata: map[string]any{}}}, nil
+	}
+	var docs []schema.Document
+	for i, e := range elems {
+		docs = append(docs, schema.Document{
+			PageContent: string(e),
+			Metadata:    map[string]any{"row": i + 1},
+		})
+	}
+	return docs, nil
+}
+
+// loadJSONL loads a JSON Lines file. Each line is a record.
+func loadJSONL(r io.Reader) ([]schema.Document, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var docs []schema.Document
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !json.Valid([]byte(line)) {
+			return nil, fmt.Errorf("invalid json at line %d", i+1)
+		}
+		docs = append(docs, schema.Document{
+			PageContent: line,
+			Metadata:    map[string]any{"row": i + 1},
+		})
+	}
+	return docs, nil
+}
+
+// loadDOCX loads the text of a Word document.
+func loadDOCX(path string) ([]schema.Document, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %s", err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	for _, f := range r.File {
+		if f.Name != "word/document.xml" {
+			continue
+		}
+		text, err := zipXMLText(f)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %s", f.Name, err)
+		}
+		return []schema.Document{{PageContent: text, Metadata: map[string]any{}}}, nil
+	}
+	return nil, fmt.Errorf("word/document.xml not found")
+}
+
+// loadPPTX loads the text of a PowerPoint presentation. Each slide is a document.
+func loadPPTX(path string) ([]schema.Document, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %s", err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	type slide struct {
+		num  int
+		file *zip.File
+	}
+	var slides []slide
+	for _, f := range r.File {
+		m := pptxSlideRe.FindStringSubmatch(f.Name)
+		if m == nil {
+			continue
+		}
+		num, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, err
+		}
+		slides = append(slides, slide{num: num, file: f})
+	}
+	sort.Slice(slides, func(i, j int) bool { return slides[i].num < slides[j].num })
+
+	var docs []schema.Document
+	for _, s := range slides {
+		text, err := zipXMLText(s.file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %s", s.file.Name, err)
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		docs = append(docs, schema.Document{
+			PageContent: text,
+			Metadata:    map[string]any{metadataKeySlide: s.num},
+		})
+	}
+	return docs, nil
+}
+
+// htmlToMarkdown extracts the text of an HTML document and marks headings with Markdown syntax so that the document
+// can be split into sections.
+func htmlToMarkdown(r io.Reader) (string, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return "", fmt.Errorf("parse html: %s", err)
+	}
+
+	var sb strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			// Collapse whitespace as browsers do.
+			t := spacesRe.ReplaceAllString(n.Data, " ")
+			if s := sb.String(); s == "" || strings.HasSuffix(s, "\n") || strings.HasSuffix(s, " ") {
+				t = strings.TrimLeft(t, " ")
+			}
+			sb.WriteString(t)
+			return
+		case html.ElementNode:
+			switch n.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Noscript, atom.Template:
+				return
+			case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+				level := int(n.Data[1] - '0')
+				sb.WriteString("\n\n" + strings.Repeat("#", level) + " ")
+				for c := n.FirstChild; c != nil; c = c.NextSibling {
+					walk(c)
+				}
+				sb.WriteString("\n\n")
+				return
+			case atom.P, atom.Div, atom.Section, atom.Article, atom.Li, atom.Tr, atom.Pre, atom.Blockquote, atom.Table:
+				defer sb.WriteString("\n\n")
+				sb.WriteString("\n\n")
+			case atom.Br:
+				sb.WriteString("\n")
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return strings.TrimSpace(blankLinesRe.ReplaceAllString(sb.String(), "\n\n")), nil
+}
+
+// zipXMLText extracts the text of an Office Open XML part. Text runs are in <w:t> (Word) or <a:t> (PowerPoint)
+// elements, and paragraphs are <w:p> or <a:p> elements.
+func zipXMLText(f *zip.File) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	var sb strings.Builder
+	var inText bool
+	d := xml.NewDecoder(rc)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				sb.WriteString("\t")
+			case "br":
+				sb.WriteString("\n")
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				sb.WriteString("\n")
+			}
+		case xml.CharData:
+			if inText {
+				sb.Write(t)
+			}
+		}
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
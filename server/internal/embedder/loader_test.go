@@ -0,0 +1,299 @@
+package embedder
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectFileType(t *testing.T) {
+	tcs := []struct {
+		name     string
+		fileName string
+		content  []byte
+		want     fileType
+		wantErr  bool
+	}{
+		{
+			name:     "pdf",
+			fileName: "doc",
+			content:  []byte("%PDF-1.4\n"),
+			want:     fileTypePDF,
+		},
+		{
+			name:     "html",
+			fileName: "page.txt",
+			content:  []byte("<!DOCTYPE html><html><body>hello</body></html>"),
+			want:     fileTypeHTML,
+		},
+		{
+			name:     "text",
+			fileName: "notes.txt",
+			content:  []byte("hello"),
+			want:     fileTypeText,
+		},
+		{
+			name:     "unknown extension",
+			fileName: "notes.log",
+			content:  []byte("hello"),
+			want:     fileTypeText,
+		},
+		{
+			name:     "markdown",
+			fileName: "README.md",
+			content:  []byte("# Title\n\nhello"),
+			want:     fileTypeMarkdown,
+		},
+		{
+			name:     "csv",
+			fileName: "data.CSV",
+			content:  []byte("a,b\n1,2\n"),
+			want:     fileTypeCSV,
+		},
+		{
+			name:     "json",
+			fileName: "data.json",
+			content:  []byte(`[{"a": 1}]`),
+			want:     fileTypeJSON,
+		},
+		{
+			name:     "json without extension",
+			fileName: "data",
+			content:  []byte(`{"a": 1}`),
+			want:     fileTypeJSON,
+		},
+		{
+			name:     "jsonl",
+			fileName: "data.jsonl",
+			content:  []byte("{\"a\": 1}\n{\"a\": 2}\n"),
+			want:     fileTypeJSONL,
+		},
+		{
+			name:     "code",
+			fileName: "main.go",
+			content:  []byte("package main\n"),
+			want:     fileTypeCode,
+		},
+		{
+			name:     "config",
+			fileName: "values.yaml",
+			content:  []byte("message: hello\n"),
+			want:     fileTypeText,
+		},
+		{
+			name:     "docx",
+			fileName: "doc.bin",
+			content:  zipContent(t, map[string]string{"word/document.xml": "<w:document/>"}),
+			want:     fileTypeDOCX,
+		},
+		{
+			name:     "pptx",
+			fileName: "slides",
+			content:  zipContent(t, map[string]string{"ppt/presentation.xml": "<p:presentation/>"}),
+			want:     fileTypePPTX,
+		},
+		{
+			name:     "other zip",
+			fileName: "archive.zip",
+			content:  zipContent(t, map[string]string{"a.txt": "hello"}),
+			wantErr:  true,
+		},
+		{
+			name:     "binary",
+			fileName: "image.png",
+			content:  []byte("\x89PNG\x0D\x0A\x1A\x0A"),
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeTempFile(t, tc.content)
+			got, err := detectFileType(path, tc.fileName)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSplitFile_Formats(t *testing.T) {
+	tcs := []struct {
+		name     string
+		fileName string
+		content  []byte
+		want     []string
+	}{
+		{
+			name:     "markdown",
+			fileName: "README.md",
+			content:  []byte("# Install\n\nRun make.\n\n# Usage\n\nRun the binary.\n"),
+			want: []string{
+				"# Install\nRun make.",
+				"# Usage\nRun the binary.",
+			},
+		},
+		{
+			name:     "csv",
+			fileName: "data.csv",
+			content:  []byte("name,age\nalice,30\nbob,40\n"),
+			want: []string{
+				"name: alice\nage: 30",
+				"name: bob\nage: 40",
+			},
+		},
+		{
+			name:     "json array",
+			fileName: "data.json",
+			content:  []byte(`[{"name": "alice"}, {"name": "bob"}]`),
+			want: []string{
+				`{"name": "alice"}`,
+				`{"name": "bob"}`,
+			},
+		},
+		{
+			name:     "jsonl",
+			fileName: "data.jsonl",
+			content:  []byte("{\"name\": \"alice\"}\n\n{\"name\": \"bob\"}\n"),
+			want: []string{
+				`{"name": "alice"}`,
+				`{"name": "bob"}`,
+			},
+		},
+		{
+			name:     "code",
+			fileName: "main.go",
+			content: []byte(`package main
+
+func a() {
+	println("a")
+}
+
+func b() {
+	println("b")
+}
+`),
+			want: []string{
+				"package main",
+				"func a() {\n\tprintln(\"a\")\n}",
+				"func b() {\n\tprintln(\"b\")\n}",
+			},
+		},
+		{
+			name:     "java",
+			fileName: "A.java",
+			content: []byte(`public class A {
+    public A() {
+        x = 1;
+    }
+
+    private void b() {}
+}
+`),
+			want: []string{
+				"public class A {",
+				"public A() {\n        x = 1;\n    }",
+				"private void b() {}\n}",
+			},
+		},
+		{
+			name:     "docx",
+			fileName: "doc.docx",
+			content: zipContent(t, map[string]string{
+				"word/document.xml": `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+					`<w:p><w:r><w:t>Hello</w:t></w:r><w:r><w:t xml:space="preserve"> world</w:t></w:r></w:p>` +
+					`<w:p><w:r><w:t>Bye</w:t></w:r></w:p>` +
+					`</w:body></w:document>`,
+			}),
+			want: []string{
+				"Hello world\nBye",
+			},
+		},
+		{
+			name:     "pptx",
+			fileName: "slides.pptx",
+			content: zipContent(t, map[string]string{
+				"ppt/presentation.xml":   `<p:presentation/>`,
+				"ppt/slides/slide10.xml": `<p:sld xmlns:a="a"><a:p><a:r><a:t>Last</a:t></a:r></a:p></p:sld>`,
+				"ppt/slides/slide2.xml":  `<p:sld xmlns:a="a"><a:p><a:r><a:t>Second</a:t></a:r></a:p></p:sld>`,
+				"ppt/slides/slide1.xml":  `<p:sld xmlns:a="a"><a:p><a:r><a:t>First</a:t></a:r></a:p></p:sld>`,
+			}),
+			want: []string{
+				"First",
+				"Second",
+				"Last",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeTempFile(t, tc.content)
+			chunking := Chunking{MaxChunkSizeTokens: 10}
+			docs, _, err := splitFile(context.Background(), path, tc.fileName, chunking, defaultContextLengthTokens, nil, nil)
+			assert.NoError(t, err)
+			var got []string
+			for _, d := range docs {
+				got = append(got, d.PageContent)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSplitFile_Tokenizer(t *testing.T) {
+	// Each character of the CJK text is a token while the character-based estimate assumes four characters per token.
+	path := writeTempFile(t, []byte("東京 大阪 京都 名古屋 福岡 札幌"))
+	chunking := Chunking{MaxChunkSizeTokens: 5}
+	docs, _, err := splitFile(context.Background(), path, "cities.txt", chunking, defaultContextLengthTokens, &charTokenizer{}, nil)
+	assert.NoError(t, err)
+	var got []string
+	for _, d := range docs {
+		got = append(got, d.PageContent)
+	}
+	assert.Equal(t, []string{"東京 大阪", "京都 名古屋", "福岡 札幌"}, got)
+
+	docs, _, err = splitFile(context.Background(), path, "cities.txt", chunking, defaultContextLengthTokens, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+}
+
+// charTokenizer counts each non-space character as a token.
+type charTokenizer struct{}
+
+func (t *charTokenizer) CountTokens(text string) int {
+	return utf8.RuneCountInString(strings.ReplaceAll(text, " ", ""))
+}
+
+func writeTempFile(t *testing.T, content []byte) string {
+	path := filepath.Join(t.TempDir(), "file")
+	err := os.WriteFile(path, content, 0600)
+	require.NoError(t, err)
+	return path
+}
+
+func zipContent(t *testing.T, files map[string]string) []byte {
+	path := filepath.Join(t.TempDir(), "file.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,195 @@
+package embedder
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+// Keys of the metadata of documents. The page and the slide are set by loaders. The others are set by splitDocuments.
+const (
+	metadataKeyPage        = "page"
+	metadataKeySlide       = "slide"
+	metadataKeyHeadings    = "headings"
+	metadataKeyChunkIndex  = "chunkIndex"
+	metadataKeyStartOffset = "startOffset"
+	metadataKeyEndOffset   = "endOffset"
+)
+
+var (
+	mdHeadingRe = regexp.MustCompile(`^ {0,3}(#{1,6})[ \t]+(.*?)(?:[ \t]+#+)?[ \t]*$`)
+	mdFenceRe   = regexp.MustCompile("^ {0,3}(```|~~~)")
+)
+
+// splitDocuments splits documents loaded from a file into chunks and records the location of each chunk in the
+// metadata. Offsets are measured in the text of the file, where the texts of the documents are joined by a newline.
+// If markdown is true, the documents are Markdown and the headings of the sections containing each chunk are recorded.
+func splitDocuments(splitter textsplitter.TextSplitter, docs []schema.Document, markdown bool) ([]schema.Document, error) {
+	var chunks []schema.Document
+	var base int
+	for _, doc := range docs {
+		texts, err := splitter.SplitText(doc.PageContent)
+		if err != nil {
+			return nil, err
+		}
+		var headings []mdHeading
+		if markdown {
+			headings = findMarkdownHeadings(doc.PageContent)
+		}
+		l := newLocator(doc.PageContent)
+		for _, text := range texts {
+			md := make(map[string]any, len(doc.Metadata)+4)
+			for k, v := range doc.Metadata {
+				md[k] = v
+			}
+			md[metadataKeyChunkIndex] = len(chunks)
+			if start, end, ok := l.locate(text); ok {
+				md[metadataKeyStartOffset] = base + start
+				md[metadataKeyEndOffset] = base + end
+				if hs := headingPath(headings, start); len(hs) > 0 {
+					md[metadataKeyHeadings] = hs
+				}
+			}
+			chunks = append(chunks, schema.Document{PageContent: text, Metadata: md})
+		}
+		base += utf8.RuneCountInString(doc.PageContent) + 1
+	}
+	return chunks, nil
+}
+
+// toChunkMetadata converts the metadata of a chunk to the metadata stored in the vector store.
+func toChunkMetadata(doc schema.Document) milvus.ChunkMetadata {
+	var m milvus.ChunkMetadata
+	if p, ok := doc.Metadata[metadataKeyPage].(int); ok {
+		m.Page = p
+	} else if s, ok := doc.Metadata[metadataKeySlide].(int); ok {
+		m.Page = s
+	}
+	m.Headings, _ = doc.Metadata[metadataKeyHeadings].([]string)
+	m.ChunkIndex, _ = doc.Metadata[metadataKeyChunkIndex].(int)
+	m.StartOffset, _ = doc.Metadata[metadataKeyStartOffset].(int)
+	m.EndOffset, _ = doc.Metadata[metadataKeyEndOffset].(int)
+	return m
+}
+
+// locator locates chunks in the text that they are split from. Splitters may change whitespace (e.g., joining
+// sentences with a space), so whitespace is ignored when comparing the chunks with the text.
+type locator struct {
+	// norm is the text where each run of whitespace is replaced with a space.
+	norm string
+	// offsets maps a byte position in norm to the character offset in the text.
+	offsets []int
+	// next is the position in norm to start searching the next chunk. Chunks are split in order but may overlap.
+	next int
+}
+
+func newLocator(text string) *locator {
+	var sb strings.Builder
+	var offsets []int
+	var i int
+	space := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			if !space {
+				sb.WriteByte(' ')
+				offsets = append(offsets, i)
+			}
+			space = true
+			i++
+			continue
+		}
+		space = false
+		n, _ := sb.WriteRune(r)
+		for range n {
+			offsets = append(offsets, i)
+		}
+		i++
+	}
+	return &locator{norm: sb.String(), offsets: offsets}
+}
+
+// locate returns the character offsets of a chunk in the text. The end offset is exclusive. It returns false if the
+// chunk is not found.
+func (l *locator) locate(chunk string) (int, int, bool) {
+	candidates := []string{chunk}
+	// The Markdown splitter prepends the heading of a section to each chunk of the section.
+	if first, rest, ok := strings.Cut(chunk, "\n"); ok && mdHeadingRe.MatchString(first) {
+		candidates = append(candidates, rest)
+	}
+	for _, c := range candidates {
+		c = strings.TrimSpace(spacesOnly(c))
+		if c == "" {
+			continue
+		}
+		i := strings.Index(l.norm[l.next:], c)
+		if i < 0 {
+			continue
+		}
+		start := l.next + i
+		end := start + len(c)
+		l.next = start + 1
+		return l.offsets[start], l.offsets[end-1] + 1, true
+	}
+	return 0, 0, false
+}
+
+// spacesOnly replaces each run of whitespace with a space.
+func spacesOnly(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// mdHeading is an ATX heading in a Markdown text.
+type mdHeading struct {
+	level int
+	text  string
+	// offset is the character offset of the heading in the text.
+	offset int
+}
+
+// findMarkdownHeadings returns the ATX headings in a Markdown text. Lines in fenced code blocks are skipped.
+func findMarkdownHeadings(text string) []mdHeading {
+	var hs []mdHeading
+	var offset int
+	var fence string
+	for _, line := range strings.SplitAfter(text, "\n") {
+		l := strings.TrimRight(line, "\r\n")
+		if m := mdFenceRe.FindStringSubmatch(l); m != nil {
+			switch fence {
+			case "":
+				fence = m[1]
+			case m[1]:
+				fence = ""
+			}
+		} else if fence == "" {
+			if m := mdHeadingRe.FindStringSubmatch(l); m != nil {
+				hs = append(hs, mdHeading{level: len(m[1]), text: m[2], offset: offset})
+			}
+		}
+		offset += utf8.RuneCountInString(line)
+	}
+	return hs
+}
+
+// headingPath returns the headings of the sections containing the given offset, from the outermost one.
+func headingPath(headings []mdHeading, offset int) []string {
+	var path []mdHeading
+	for _, h := range headings {
+		if h.offset > offset {
+			break
+		}
+		for len(path) > 0 && path[len(path)-1].level >= h.level {
+			path = path[:len(path)-1]
+		}
+		path = append(path, h)
+	}
+	var texts []string
+	for _, h := range path {
+		texts = append(texts, h.text)
+	}
+	return texts
+}
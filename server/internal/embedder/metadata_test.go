@@ -0,0 +1,89 @@
+package embedder
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+func TestSplitDocuments(t *testing.T) {
+	tcs := []struct {
+		name     string
+		docs     []schema.Document
+		splitter textsplitter.TextSplitter
+		markdown bool
+		want     []milvus.ChunkMetadata
+	}{
+		{
+			name: "pages",
+			docs: []schema.Document{
+				{PageContent: "One two. Three four.", Metadata: map[string]any{metadataKeyPage: 1}},
+				{PageContent: "Five six.", Metadata: map[string]any{metadataKeyPage: 2}},
+			},
+			splitter: &sentenceSplitter{
+				chunkSize: 12,
+				lenFunc:   utf8.RuneCountInString,
+				long:      textsplitter.NewRecursiveCharacter(textsplitter.WithChunkSize(12)),
+			},
+			want: []milvus.ChunkMetadata{
+				{Page: 1, ChunkIndex: 0, StartOffset: 0, EndOffset: 8},
+				{Page: 1, ChunkIndex: 1, StartOffset: 9, EndOffset: 20},
+				{Page: 2, ChunkIndex: 2, StartOffset: 21, EndOffset: 30},
+			},
+		},
+		{
+			name: "markdown headings",
+			docs: []schema.Document{
+				{
+					PageContent: "# Guide\n\nIntro.\n\n## Install\n\nRun make.\n\n```\n# not a heading\n```\n\n# Usage\n\nRun it.",
+					Metadata:    map[string]any{},
+				},
+			},
+			splitter: textsplitter.NewMarkdownTextSplitter(
+				textsplitter.WithChunkSize(100),
+				textsplitter.WithChunkOverlap(0),
+				textsplitter.WithCodeBlocks(true),
+			),
+			markdown: true,
+			want: []milvus.ChunkMetadata{
+				{Headings: []string{"Guide"}, ChunkIndex: 0, StartOffset: 0, EndOffset: 15},
+				// The heading in the code block is ignored.
+				{Headings: []string{"Guide", "Install"}, ChunkIndex: 1, StartOffset: 17, EndOffset: 63},
+				{Headings: []string{"Usage"}, ChunkIndex: 2, StartOffset: 65, EndOffset: 81},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks, err := splitDocuments(tc.splitter, tc.docs, tc.markdown)
+			assert.NoError(t, err)
+			var got []milvus.ChunkMetadata
+			for _, c := range chunks {
+				got = append(got, toChunkMetadata(c))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLocator(t *testing.T) {
+	text := "Alpha  beta.\nGamma delta. Alpha beta."
+	l := newLocator(text)
+
+	start, end, ok := l.locate("Alpha beta.")
+	assert.True(t, ok)
+	assert.Equal(t, "Alpha  beta.", text[start:end])
+
+	// The same text is found after the previous chunk.
+	start, end, ok = l.locate("Alpha beta.")
+	assert.True(t, ok)
+	assert.Equal(t, 26, start)
+	assert.Equal(t, 37, end)
+
+	_, _, ok = l.locate("Epsilon.")
+	assert.False(t, ok)
+}
@@ -0,0 +1,97 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// dimensionsProbe is the text embedded to discover the dimensions of a model.
+const dimensionsProbe = "dimensions probe"
+
+// DimensionsFunc returns the dimensions of an embedding model. It returns an error if the model is not known.
+type DimensionsFunc func(model string) (int, error)
+
+// NewModelRegistry creates a new model registry. The overrides take precedence over discovery, and fallback is used
+// when the dimensions cannot be discovered (e.g., the LLM engine is not reachable). Either can be nil.
+func NewModelRegistry(
+	llmClient LLMClient,
+	overrides map[string]int,
+	fallback DimensionsFunc,
+	log logr.Logger,
+) *ModelRegistry {
+	return &ModelRegistry{
+		llmClient: llmClient,
+		overrides: overrides,
+		fallback:  fallback,
+		dims:      map[string]int{},
+		log:       log.WithName("models"),
+	}
+}
+
+// ModelRegistry knows the dimensions of embedding models. The dimensions are discovered by embedding a probe text
+// with the LLM engine and cached.
+type ModelRegistry struct {
+	llmClient LLMClient
+	overrides map[string]int
+	fallback  DimensionsFunc
+
+	// mu protects dims.
+	mu sync.Mutex
+	// dims is keyed by model name.
+	dims map[string]int
+
+	log logr.Logger
+}
+
+// Dimensions returns the dimensions of an embedding model. It returns an error wrapping ErrUnknownModel if the LLM
+// engine does not serve the model.
+func (r *ModelRegistry) Dimensions(ctx context.Context, model string) (int, error) {
+	if d, ok := r.overrides[model]; ok {
+		return d, nil
+	}
+
+	r.mu.Lock()
+	d, ok := r.dims[model]
+	r.mu.Unlock()
+	if ok {
+		return d, nil
+	}
+
+	d, err := r.discover(ctx, model)
+	if err != nil {
+		if r.fallback == nil || errors.Is(err, ErrUnknownModel) {
+			return 0, err
+		}
+		fd, ferr := r.fallback(model)
+		if ferr != nil {
+			return 0, fmt.Errorf("discover dimensions of model %q: %s", model, err)
+		}
+		r.log.Error(err, "Failed to discover dimensions. Using the static dimensions", "model", model, "dimensions", fd)
+		// Do not cache the fallback so that discovery is retried.
+		return fd, nil
+	}
+
+	r.mu.Lock()
+	r.dims[model] = d
+	r.mu.Unlock()
+	r.log.Info("Discovered dimensions", "model", model, "dimensions", d)
+	return d, nil
+}
+
+func (r *ModelRegistry) discover(ctx context.Context, model string) (int, error) {
+	if err := r.llmClient.PullModel(ctx, model); err != nil {
+		return 0, fmt.Errorf("pull model: %w", err)
+	}
+	v, err := r.llmClient.Embed(ctx, model, dimensionsProbe)
+	if err != nil {
+		return 0, fmt.Errorf("embed: %w", err)
+	}
+	if len(v) == 0 {
+		return 0, fmt.Errorf("empty embedding")
+	}
+	return len(v), nil
+}
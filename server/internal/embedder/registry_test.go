@@ -0,0 +1,88 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelRegistry(t *testing.T) {
+	llm := &probeLLMClient{
+		dims: map[string]int{
+			"model0": 384,
+			"model1": 768,
+		},
+		unreachable: map[string]bool{
+			"static":      true,
+			"unreachable": true,
+		},
+	}
+	fallback := func(model string) (int, error) {
+		if model == "static" {
+			return 1024, nil
+		}
+		return 0, fmt.Errorf("unknown model %q", model)
+	}
+	r := NewModelRegistry(llm, map[string]int{"model1": 1536}, fallback, testr.New(t))
+	ctx := context.Background()
+
+	d, err := r.Dimensions(ctx, "model0")
+	assert.NoError(t, err)
+	assert.Equal(t, 384, d)
+	assert.Equal(t, 1, llm.numEmbeds)
+
+	// The dimensions are cached.
+	d, err = r.Dimensions(ctx, "model0")
+	assert.NoError(t, err)
+	assert.Equal(t, 384, d)
+	assert.Equal(t, 1, llm.numEmbeds)
+
+	// Overrides take precedence.
+	d, err = r.Dimensions(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1536, d)
+	assert.Equal(t, 1, llm.numEmbeds)
+
+	// The static dimensions are used if discovery fails.
+	d, err = r.Dimensions(ctx, "static")
+	assert.NoError(t, err)
+	assert.Equal(t, 1024, d)
+
+	// The static dimensions are not used if the LLM engine does not serve the model.
+	_, err = r.Dimensions(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrUnknownModel)
+
+	_, err = r.Dimensions(ctx, "unreachable")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUnknownModel)
+}
+
+type probeLLMClient struct {
+	dims map[string]int
+	// unreachable is the set of models whose embedding requests fail as if the LLM engine were not reachable.
+	unreachable map[string]bool
+	numEmbeds   int
+}
+
+func (c *probeLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	c.numEmbeds++
+	if c.unreachable[modelName] {
+		return nil, fmt.Errorf("connection refused")
+	}
+	d, ok := c.dims[modelName]
+	if !ok {
+		return nil, fmt.Errorf("%w: model %q not found", ErrUnknownModel, modelName)
+	}
+	return make([]float32, d), nil
+}
+
+func (c *probeLLMClient) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *probeLLMClient) PullModel(ctx context.Context, modelName string) error {
+	return nil
+}
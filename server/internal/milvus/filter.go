@@ -0,0 +1,139 @@
+package milvus
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilterType is the type of a filter.
+type FilterType string
+
+const (
+	// FilterTypeEq matches documents whose attribute is equal to the value.
+	FilterTypeEq FilterType = "eq"
+	// FilterTypeNe matches documents whose attribute is not equal to the value.
+	FilterTypeNe FilterType = "ne"
+	// FilterTypeGt matches documents whose attribute is greater than the value.
+	FilterTypeGt FilterType = "gt"
+	// FilterTypeGte matches documents whose attribute is greater than or equal to the value.
+	FilterTypeGte FilterType = "gte"
+	// FilterTypeLt matches documents whose attribute is less than the value.
+	FilterTypeLt FilterType = "lt"
+	// FilterTypeLte matches documents whose attribute is less than or equal to the value.
+	FilterTypeLte FilterType = "lte"
+	// FilterTypeAnd matches documents that match all of the sub filters.
+	FilterTypeAnd FilterType = "and"
+	// FilterTypeOr matches documents that match any of the sub filters.
+	FilterTypeOr FilterType = "or"
+)
+
+const (
+	// maxFilterDepth is the maximum nesting depth of a filter. A comparison filter has a depth of 1.
+	maxFilterDepth = 5
+	// maxFilterClauses is the maximum number of filters, including compound filters, in a filter.
+	maxFilterClauses = 50
+)
+
+var (
+	comparisonOperators = map[FilterType]string{
+		FilterTypeEq:  "==",
+		FilterTypeNe:  "!=",
+		FilterTypeGt:  ">",
+		FilterTypeGte: ">=",
+		FilterTypeLt:  "<",
+		FilterTypeLte: "<=",
+	}
+
+	attributeKeyRe = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+)
+
+// Filter is a filter on the attributes of documents. A comparison filter compares the attribute of Key with Value.
+// A compound filter combines Filters.
+type Filter struct {
+	Type    FilterType
+	Key     string
+	Value   string
+	Filters []*Filter
+}
+
+// Expr converts the filter to a Milvus boolean expression. The filter must not be nested deeper than maxFilterDepth
+// or have more than maxFilterClauses filters so that the expression stays small.
+func (f *Filter) Expr() (string, error) {
+	var n int
+	return f.expr(1, &n)
+}
+
+// expr converts the filter at the given depth to an expression. n is the number of filters converted so far.
+func (f *Filter) expr(depth int, n *int) (string, error) {
+	if depth > maxFilterDepth {
+		return "", fmt.Errorf("filters must not be nested more than %d levels deep", maxFilterDepth)
+	}
+	*n++
+	if *n > maxFilterClauses {
+		return "", fmt.Errorf("filters must not have more than %d filters", maxFilterClauses)
+	}
+
+	if op, ok := comparisonOperators[f.Type]; ok {
+		if err := ValidateAttributeKey(f.Key); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s[%s] %s %s", attributesColName, strconv.Quote(f.Key), op, attributeValueLiteral(f.Value)), nil
+	}
+
+	var sep string
+	switch f.Type {
+	case FilterTypeAnd:
+		sep = " and "
+	case FilterTypeOr:
+		sep = " or "
+	default:
+		return "", fmt.Errorf("unsupported filter type %q", f.Type)
+	}
+	if len(f.Filters) == 0 {
+		return "", fmt.Errorf("%s filter must have at least one filter", f.Type)
+	}
+	var exprs []string
+	for _, sf := range f.Filters {
+		expr, err := sf.expr(depth+1, n)
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, expr)
+	}
+	return "(" + strings.Join(exprs, sep) + ")", nil
+}
+
+// ValidateAttributeKey validates the key of an attribute so that it can be used in a filter expression.
+func ValidateAttributeKey(key string) error {
+	if !attributeKeyRe.MatchString(key) {
+		return fmt.Errorf("attribute key %q must consist of alphanumeric characters, '_', '.' or '-'", key)
+	}
+	return nil
+}
+
+// attributeValue converts an attribute value to the value stored in Milvus. Numbers and booleans are stored as is
+// so that they can be compared numerically. Other values are stored as strings.
+func attributeValue(v string) interface{} {
+	if v == "true" || v == "false" {
+		return v == "true"
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return v
+}
+
+// attributeValueLiteral converts an attribute value to a literal in a filter expression.
+func attributeValueLiteral(v string) string {
+	switch av := attributeValue(v).(type) {
+	case bool:
+		return strconv.FormatBool(av)
+	case float64:
+		return strconv.FormatFloat(av, 'g', -1, 64)
+	default:
+		return strconv.Quote(v)
+	}
+}
@@ -0,0 +1,120 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterExpr(t *testing.T) {
+	tcs := []struct {
+		name    string
+		filter  *Filter
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "string",
+			filter: &Filter{Type: FilterTypeEq, Key: "lang", Value: "en"},
+			want:   `attributes["lang"] == "en"`,
+		},
+		{
+			name:   "number",
+			filter: &Filter{Type: FilterTypeLt, Key: "year", Value: "2024"},
+			want:   `attributes["year"] < 2024`,
+		},
+		{
+			name:   "boolean",
+			filter: &Filter{Type: FilterTypeNe, Key: "draft", Value: "true"},
+			want:   `attributes["draft"] != true`,
+		},
+		{
+			name:   "quoted string",
+			filter: &Filter{Type: FilterTypeEq, Key: "title", Value: `say "hi"`},
+			want:   `attributes["title"] == "say \"hi\""`,
+		},
+		{
+			name: "compound",
+			filter: &Filter{
+				Type: FilterTypeOr,
+				Filters: []*Filter{
+					{Type: FilterTypeGte, Key: "score", Value: "0.5"},
+					{
+						Type: FilterTypeAnd,
+						Filters: []*Filter{
+							{Type: FilterTypeEq, Key: "a", Value: "x"},
+							{Type: FilterTypeLte, Key: "b", Value: "1"},
+						},
+					},
+				},
+			},
+			want: `(attributes["score"] >= 0.5 or (attributes["a"] == "x" and attributes["b"] <= 1))`,
+		},
+		{
+			name:    "invalid key",
+			filter:  &Filter{Type: FilterTypeEq, Key: `a"] or true or x["`, Value: "v"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			filter:  &Filter{Type: "in", Key: "a", Value: "v"},
+			wantErr: true,
+		},
+		{
+			name:    "empty compound",
+			filter:  &Filter{Type: FilterTypeAnd},
+			wantErr: true,
+		},
+		{
+			name:   "max depth",
+			filter: nestedFilter(maxFilterDepth),
+			want:   `((((attributes["a"] == "x"))))`,
+		},
+		{
+			name:    "too deep",
+			filter:  nestedFilter(maxFilterDepth + 1),
+			wantErr: true,
+		},
+		{
+			name:    "too many filters",
+			filter:  &Filter{Type: FilterTypeOr, Filters: comparisonFilters(maxFilterClauses)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.filter.Expr()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// nestedFilter returns a filter of the given depth.
+func nestedFilter(depth int) *Filter {
+	f := &Filter{Type: FilterTypeEq, Key: "a", Value: "x"}
+	for i := 1; i < depth; i++ {
+		f = &Filter{Type: FilterTypeAnd, Filters: []*Filter{f}}
+	}
+	return f
+}
+
+func comparisonFilters(n int) []*Filter {
+	var fs []*Filter
+	for i := 0; i < n; i++ {
+		fs = append(fs, &Filter{Type: FilterTypeEq, Key: "a", Value: "x"})
+	}
+	return fs
+}
+
+func TestAttributeValue(t *testing.T) {
+	assert.Equal(t, "en", attributeValue("en"))
+	assert.Equal(t, 2024.0, attributeValue("2024"))
+	assert.Equal(t, true, attributeValue("true"))
+	assert.Equal(t, "True", attributeValue("True"))
+	assert.Equal(t, "NaN", attributeValue("NaN"))
+}
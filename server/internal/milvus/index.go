@@ -0,0 +1,127 @@
+package milvus
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+const (
+	defaultIndexType      = entity.IvfFlat
+	defaultHNSWM          = 16
+	defaultEfConstruction = 200
+	defaultHNSWEf         = 64
+	defaultSearchList     = 100
+)
+
+// IndexConfig is the configuration of the vector index of a collection. Zero values are replaced with defaults.
+type IndexConfig struct {
+	MetricType entity.MetricType
+	IndexType  entity.IndexType
+
+	// NList is the number of cluster units of IVF indexes.
+	NList int
+	// NProbe is the number of cluster units to search with IVF indexes.
+	NProbe int
+
+	// M is the maximum degree of nodes of HNSW indexes.
+	M int
+	// EfConstruction is the size of the candidate list when building HNSW indexes.
+	EfConstruction int
+	// Ef is the size of the candidate list when searching HNSW indexes.
+	Ef int
+
+	// SearchList is the size of the candidate list when searching DiskANN indexes.
+	SearchList int
+}
+
+// WithDefaults returns a copy of the configuration where unset parameters are replaced with defaults.
+// The defaults match the index of collections created before the index became configurable.
+func (c IndexConfig) WithDefaults() IndexConfig {
+	if c.MetricType == "" {
+		c.MetricType = defaultMetricType
+	}
+	if c.IndexType == "" {
+		c.IndexType = defaultIndexType
+	}
+	switch c.IndexType {
+	case entity.IvfFlat, entity.IvfSQ8:
+		if c.NList == 0 {
+			c.NList = defaultIvfFlatNList
+		}
+		if c.NProbe == 0 {
+			c.NProbe = defaultIvfFlatSearchParam
+		}
+	case entity.HNSW:
+		if c.M == 0 {
+			c.M = defaultHNSWM
+		}
+		if c.EfConstruction == 0 {
+			c.EfConstruction = defaultEfConstruction
+		}
+		if c.Ef == 0 {
+			c.Ef = defaultHNSWEf
+		}
+	case entity.DISKANN:
+		if c.SearchList == 0 {
+			c.SearchList = defaultSearchList
+		}
+	}
+	return c
+}
+
+// Validate validates the configuration. It should be called after WithDefaults.
+func (c IndexConfig) Validate() error {
+	switch c.MetricType {
+	case entity.L2, entity.IP, entity.COSINE:
+	default:
+		return fmt.Errorf("unsupported metric type %q", c.MetricType)
+	}
+	if _, err := c.index(); err != nil {
+		return err
+	}
+	if _, err := c.searchParam(1); err != nil {
+		return err
+	}
+	if (c.IndexType == entity.IvfFlat || c.IndexType == entity.IvfSQ8) && c.NProbe > c.NList {
+		return fmt.Errorf("nprobe must not be greater than nlist")
+	}
+	return nil
+}
+
+func (c IndexConfig) index() (entity.Index, error) {
+	switch c.IndexType {
+	case entity.Flat:
+		return entity.NewIndexFlat(c.MetricType)
+	case entity.IvfFlat:
+		return entity.NewIndexIvfFlat(c.MetricType, c.NList)
+	case entity.IvfSQ8:
+		return entity.NewIndexIvfSQ8(c.MetricType, c.NList)
+	case entity.HNSW:
+		return entity.NewIndexHNSW(c.MetricType, c.M, c.EfConstruction)
+	case entity.DISKANN:
+		return entity.NewIndexDISKANN(c.MetricType)
+	default:
+		return nil, fmt.Errorf("unsupported index type %q", c.IndexType)
+	}
+}
+
+// searchParam returns the search parameters for retrieving topK documents.
+func (c IndexConfig) searchParam(topK int) (entity.SearchParam, error) {
+	switch c.IndexType {
+	case entity.Flat:
+		return entity.NewIndexFlatSearchParam()
+	case entity.IvfFlat:
+		return entity.NewIndexIvfFlatSearchParam(c.NProbe)
+	case entity.IvfSQ8:
+		return entity.NewIndexIvfSQ8SearchParam(c.NProbe)
+	case entity.HNSW:
+		// Milvus requires ef to be at least topK.
+		return entity.NewIndexHNSWSearchParam(max(c.Ef, topK))
+	case entity.DISKANN:
+		// Milvus requires search_list to be at least topK.
+		return entity.NewIndexDISKANNSearchParam(max(c.SearchList, topK))
+	default:
+		return nil, fmt.Errorf("unsupported index type %q", c.IndexType)
+	}
+}
@@ -0,0 +1,108 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexConfig(t *testing.T) {
+	tcs := []struct {
+		name    string
+		config  IndexConfig
+		want    IndexConfig
+		wantErr bool
+	}{
+		{
+			name:   "default",
+			config: IndexConfig{},
+			want: IndexConfig{
+				MetricType: entity.L2,
+				IndexType:  entity.IvfFlat,
+				NList:      128,
+				NProbe:     16,
+			},
+		},
+		{
+			name: "hnsw",
+			config: IndexConfig{
+				MetricType: entity.COSINE,
+				IndexType:  entity.HNSW,
+				Ef:         100,
+			},
+			want: IndexConfig{
+				MetricType:     entity.COSINE,
+				IndexType:      entity.HNSW,
+				M:              16,
+				EfConstruction: 200,
+				Ef:             100,
+			},
+		},
+		{
+			name: "flat",
+			config: IndexConfig{
+				MetricType: entity.IP,
+				IndexType:  entity.Flat,
+			},
+			want: IndexConfig{
+				MetricType: entity.IP,
+				IndexType:  entity.Flat,
+			},
+		},
+		{
+			name: "unsupported metric type",
+			config: IndexConfig{
+				MetricType: entity.HAMMING,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported index type",
+			config: IndexConfig{
+				IndexType: entity.SCANN,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid hnsw param",
+			config: IndexConfig{
+				IndexType: entity.HNSW,
+				M:         128,
+			},
+			wantErr: true,
+		},
+		{
+			name: "nprobe greater than nlist",
+			config: IndexConfig{
+				IndexType: entity.IvfSQ8,
+				NList:     8,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.config.WithDefaults()
+			err := got.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIndexConfig_SearchParam(t *testing.T) {
+	c := IndexConfig{IndexType: entity.HNSW}.WithDefaults()
+	sp, err := c.searchParam(10)
+	assert.NoError(t, err)
+	assert.Equal(t, 64, sp.Params()["ef"])
+
+	// ef must be at least topK.
+	sp, err = c.searchParam(100)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, sp.Params()["ef"])
+}
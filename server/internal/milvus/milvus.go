@@ -2,9 +2,11 @@ package milvus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
 	"github.com/llmariner/common/pkg/db"
@@ -18,10 +20,34 @@ const (
 	primaryKeyColName                           = "pk"
 	fileIDColName                               = "fileID"
 	textColName                                 = "text"
-	maxVarCharLength                            = 4096 * 4 // maxMaxChunkSizeTokens * charactersPerToken
+	attributesColName                           = "attributes"
+	metadataColName                             = "metadata"
+	sparseVectorColName                         = "sparse"
 	defaultMetricType         entity.MetricType = entity.L2
 	defaultIvfFlatNList                         = 128
 	defaultIvfFlatSearchParam                   = 16
+	sparseMetricType          entity.MetricType = entity.IP
+	sparseDropRatio                             = 0.0
+)
+
+// MaxTextBytes is the maximum length of the text of a document in bytes. Milvus limits the length of a VarChar field
+// in bytes, so a text of multibyte characters reaches the limit with fewer characters. Longer texts are truncated and
+// must be stored elsewhere.
+const MaxTextBytes = 4096 * 4
+
+// maxVarCharLength is the maximum length of VarChar fields in bytes.
+const maxVarCharLength = MaxTextBytes
+
+// SearchMode is the mode of a search.
+type SearchMode string
+
+const (
+	// SearchModeDense searches documents by their embeddings.
+	SearchModeDense SearchMode = "dense"
+	// SearchModeSparse searches documents by keywords.
+	SearchModeSparse SearchMode = "sparse"
+	// SearchModeHybrid runs both dense and sparse searches and fuses the results with reciprocal rank fusion.
+	SearchModeHybrid SearchMode = "hybrid"
 )
 
 // S wraps Milvus client.
@@ -55,8 +81,13 @@ func New(ctx context.Context, cfg db.Config, log logr.Logger) (*S, error) {
 	}, nil
 }
 
-// CreateVectorStore creates a new collection in milvus.
-func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error) {
+// CreateVectorStore creates a new collection in milvus. The vector index is built with the given configuration.
+func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int, index IndexConfig) (int64, error) {
+	index = index.WithDefaults()
+	if err := index.Validate(); err != nil {
+		return 0, fmt.Errorf("index config: %s", err)
+	}
+
 	schema := &entity.Schema{
 		CollectionName: name,
 		AutoID:         true,
@@ -81,6 +112,14 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 					entity.TypeParamMaxLength: strconv.Itoa(maxVarCharLength),
 				},
 			},
+			{
+				Name:     attributesColName,
+				DataType: entity.FieldTypeJSON,
+			},
+			{
+				Name:     metadataColName,
+				DataType: entity.FieldTypeJSON,
+			},
 			{
 				Name:     vectorColName,
 				DataType: entity.FieldTypeFloatVector,
@@ -88,6 +127,10 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 					entity.TypeParamDim: strconv.Itoa(dimensions),
 				},
 			},
+			{
+				Name:     sparseVectorColName,
+				DataType: entity.FieldTypeSparseVector,
+			},
 		},
 	}
 
@@ -96,15 +139,21 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 		return 0, err
 	}
 
-	// TODO(guangrui): Experiment with other type of indexing and tuning.
 	// Refer to https://milvus.io/docs/index.md#Indexes-supported-in-Milvus
-	idx, err := entity.NewIndexIvfFlat(defaultMetricType, defaultIvfFlatNList)
+	idx, err := index.index()
 	if err != nil {
-		return 0, fmt.Errorf("new ivf flat index: %s", err)
+		return 0, fmt.Errorf("new %s index: %s", index.IndexType, err)
 	}
 	if err := s.client.CreateIndex(ctx, name, vectorColName, idx, false); err != nil {
 		return 0, fmt.Errorf("create index:: %s", err)
 	}
+	sidx, err := entity.NewIndexSparseInverted(sparseMetricType, sparseDropRatio)
+	if err != nil {
+		return 0, fmt.Errorf("new sparse inverted index: %s", err)
+	}
+	if err := s.client.CreateIndex(ctx, name, sparseVectorColName, sidx, false); err != nil {
+		return 0, fmt.Errorf("create sparse index: %s", err)
+	}
 
 	c, err := s.client.DescribeCollection(ctx, name)
 	if err != nil {
@@ -115,15 +164,15 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 	return c.ID, nil
 }
 
-// ListVectorStores lists collections in milvus.
-func (s *S) ListVectorStores(ctx context.Context) ([]int64, error) {
+// ListVectorStores lists the names of collections in milvus.
+func (s *S) ListVectorStores(ctx context.Context) ([]string, error) {
 	cs, err := s.client.ListCollections(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var vss []int64
+	var vss []string
 	for _, c := range cs {
-		vss = append(vss, c.ID)
+		vss = append(vss, c.Name)
 	}
 	return vss, nil
 }
@@ -138,15 +187,141 @@ func (s *S) DeleteVectorStore(ctx context.Context, name string) error {
 	return s.client.DropCollection(ctx, name)
 }
 
-// InsertDocuments inserts documents into a collection in milvus.
-func (s *S) InsertDocuments(ctx context.Context, name string, files, texts []string, vectors [][]float32) error {
+// ChunkMetadata is the location of a document in the file that it is extracted from.
+type ChunkMetadata struct {
+	// Page is the 1-based number of the page (or slide) that the document is extracted from. It is 0 if the file
+	// does not have pages.
+	Page int `json:"page,omitempty"`
+	// Headings are the headings of the sections that contain the document, from the outermost one.
+	Headings []string `json:"headings,omitempty"`
+	// ChunkIndex is the 0-based index of the document in the file.
+	ChunkIndex int `json:"chunkIndex"`
+	// StartOffset and EndOffset are the character offsets of the document in the text extracted from the file.
+	// EndOffset is exclusive. Both are 0 if the document cannot be located in the text.
+	StartOffset int `json:"startOffset,omitempty"`
+	EndOffset   int `json:"endOffset,omitempty"`
+}
+
+// InsertDocuments inserts documents into a collection in milvus and returns the IDs of the inserted documents.
+// metadata has the same order as texts. The attributes are copied onto every document. The file IDs must not be
+// longer than MaxTextBytes. Texts longer than MaxTextBytes are stored truncated while their sparse vectors are
+// computed from the full texts.
+func (s *S) InsertDocuments(
+	ctx context.Context,
+	name string,
+	files,
+	texts []string,
+	metadata []ChunkMetadata,
+	vectors [][]float32,
+	attributes map[string]string,
+) ([]int64, error) {
+	// Validate the lengths here as Milvus rejects the whole insert.
+	for i := range files {
+		if len(files[i]) > maxVarCharLength {
+			return nil, fmt.Errorf("file ID of document %d is longer than %d bytes", i, maxVarCharLength)
+		}
+	}
+
+	fields, err := s.fields(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
 	vectorCol := entity.NewColumnFloatVector(vectorColName, len(vectors[0]), vectors)
 	fileCol := entity.NewColumnVarChar(fileIDColName, files)
-	textCol := entity.NewColumnVarChar(textColName, texts)
-	if _, err := s.client.Insert(ctx, name, "" /* partitionName */, vectorCol, fileCol, textCol); err != nil {
-		return err
+	// Collections created before sparse search was supported do not have the sparse field. Such collections only
+	// support dense search.
+	textCols, err := textColumns(texts, fields[sparseVectorColName])
+	if err != nil {
+		return nil, err
+	}
+	cols := append([]entity.Column{vectorCol, fileCol}, textCols...)
+	if fields[attributesColName] {
+		attrs := map[string]interface{}{}
+		for k, v := range attributes {
+			attrs[k] = attributeValue(v)
+		}
+		b, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, fmt.Errorf("marshal attributes: %s", err)
+		}
+		vals := make([][]byte, len(files))
+		for i := range vals {
+			vals[i] = b
+		}
+		cols = append(cols, entity.NewColumnJSONBytes(attributesColName, vals))
+	} else if len(attributes) > 0 {
+		// Collections created before attributes were supported do not have the field.
+		return nil, fmt.Errorf("collection %q does not support attributes", name)
+	}
+	// Collections created before chunk metadata was supported do not have the field. The metadata is dropped as
+	// it is not essential to search.
+	if fields[metadataColName] {
+		vals := make([][]byte, len(metadata))
+		for i, m := range metadata {
+			b, err := json.Marshal(m)
+			if err != nil {
+				return nil, fmt.Errorf("marshal metadata: %s", err)
+			}
+			vals[i] = b
+		}
+		cols = append(cols, entity.NewColumnJSONBytes(metadataColName, vals))
+	}
+	idCol, err := s.client.Insert(ctx, name, "" /* partitionName */, cols...)
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := idCol.(*entity.ColumnInt64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %s column: %T", primaryKeyColName, idCol)
+	}
+	return ids.Data(), nil
+}
+
+// textColumns returns the text column of documents and, if sparse is true, their sparse vector column. The texts are
+// truncated to MaxTextBytes after their sparse vectors are computed so that all the terms in the texts are searchable.
+func textColumns(texts []string, sparse bool) ([]entity.Column, error) {
+	truncated := make([]string, len(texts))
+	for i, t := range texts {
+		truncated[i] = truncateUTF8(t, MaxTextBytes)
+	}
+	cols := []entity.Column{entity.NewColumnVarChar(textColName, truncated)}
+	if !sparse {
+		return cols, nil
+	}
+	var svs []entity.SparseEmbedding
+	for _, t := range texts {
+		sv, err := documentSparseVector(t)
+		if err != nil {
+			return nil, fmt.Errorf("sparse vector: %s", err)
+		}
+		svs = append(svs, sv)
+	}
+	return append(cols, entity.NewColumnSparseVectors(sparseVectorColName, svs)), nil
+}
+
+// truncateUTF8 truncates a string to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
 	}
-	return nil
+	return s[:n]
+}
+
+// fields returns the names of the fields in a collection.
+func (s *S) fields(ctx context.Context, collectionName string) (map[string]bool, error) {
+	c, err := s.client.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return nil, fmt.Errorf("describe collection: %s", err)
+	}
+	fields := map[string]bool{}
+	for _, f := range c.Schema.Fields {
+		fields[f.Name] = true
+	}
+	return fields, nil
 }
 
 // DeleteDocuments deletes documents from a collection in milvus by fileID.
@@ -164,8 +339,52 @@ func (s *S) DeleteDocuments(ctx context.Context, collectionName, fileID string)
 	return s.client.Delete(ctx, collectionName, "" /* partitionName */, expr)
 }
 
-// Search searches for the documents with similar vectors in milvus. The texts of the matched documents are returned.
-func (s *S) Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error) {
+// SearchResult is a document matched by a search.
+type SearchResult struct {
+	// ChunkID is the primary key of the document in the collection.
+	ChunkID int64
+	FileID  string
+	Text    string
+	// Score is the similarity between the query and the document. A higher score means more similar.
+	Score float32
+	// Metadata is nil if the collection was created before chunk metadata was supported.
+	Metadata *ChunkMetadata
+}
+
+// Search searches for the documents matching the query in milvus. Dense search uses the vectors of the query while
+// sparse search uses the keywords in the query. The matched documents are returned in the order of relevance.
+// If filter is not nil, only documents whose attributes match the filter are returned. The index configuration must match
+// the one used to create the collection.
+func (s *S) Search(
+	ctx context.Context,
+	collectionName string,
+	index IndexConfig,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *Filter,
+	mode SearchMode,
+) ([]*SearchResult, error) {
+	var expr string
+	if filter != nil {
+		var err error
+		if expr, err = filter.Expr(); err != nil {
+			return nil, fmt.Errorf("filter: %s", err)
+		}
+	}
+
+	fields, err := s.fields(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	if mode != SearchModeDense && !fields[sparseVectorColName] {
+		return nil, fmt.Errorf("collection %q does not support %s search", collectionName, mode)
+	}
+	outputFields := []string{primaryKeyColName, fileIDColName, textColName}
+	if fields[metadataColName] {
+		outputFields = append(outputFields, metadataColName)
+	}
+
 	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
 		return nil, fmt.Errorf("load collection: %s", err)
 	}
@@ -175,21 +394,99 @@ func (s *S) Search(ctx context.Context, collectionName string, vectors []float32
 		}
 	}()
 
-	sp, err := entity.NewIndexIvfFlatSearchParam(defaultIvfFlatSearchParam)
+	switch mode {
+	case SearchModeDense:
+		return s.searchDense(ctx, collectionName, index, expr, outputFields, vectors, numDocuments)
+	case SearchModeSparse:
+		return s.searchSparse(ctx, collectionName, expr, outputFields, query, numDocuments)
+	case SearchModeHybrid:
+		dense, err := s.searchDense(ctx, collectionName, index, expr, outputFields, vectors, numDocuments)
+		if err != nil {
+			return nil, err
+		}
+		sparse, err := s.searchSparse(ctx, collectionName, expr, outputFields, query, numDocuments)
+		if err != nil {
+			return nil, err
+		}
+		return fuseRRF([][]*SearchResult{dense, sparse}, numDocuments), nil
+	default:
+		return nil, fmt.Errorf("unsupported search mode %q", mode)
+	}
+}
+
+func (s *S) searchDense(
+	ctx context.Context,
+	collectionName string,
+	index IndexConfig,
+	expr string,
+	outputFields []string,
+	vectors []float32,
+	numDocuments int,
+) ([]*SearchResult, error) {
+	index = index.WithDefaults()
+	sp, err := index.searchParam(numDocuments)
 	if err != nil {
 		return nil, err
 	}
-
 	vs := []entity.Vector{entity.FloatVector(vectors)}
+	return s.search(ctx, collectionName, expr, outputFields, vs, vectorColName, index.MetricType, numDocuments, sp)
+}
+
+func (s *S) searchSparse(
+	ctx context.Context,
+	collectionName,
+	expr string,
+	outputFields []string,
+	query string,
+	numDocuments int,
+) ([]*SearchResult, error) {
+	sv, ok, err := querySparseVector(query)
+	if err != nil {
+		return nil, fmt.Errorf("sparse vector: %s", err)
+	}
+	if !ok {
+		// No keyword to match.
+		return nil, nil
+	}
+	sp, err := entity.NewIndexSparseInvertedSearchParam(sparseDropRatio)
+	if err != nil {
+		return nil, err
+	}
+	vs := []entity.Vector{sv}
+	res, err := s.search(ctx, collectionName, expr, outputFields, vs, sparseVectorColName, sparseMetricType, numDocuments, sp)
+	if err != nil {
+		return nil, err
+	}
+	// The inner product of sparse vectors is the sum of the weights of the matched keywords and unbounded.
+	// Normalize it to [0, 1).
+	for _, r := range res {
+		if r.Score > 0 {
+			r.Score = r.Score / (1 + r.Score)
+		}
+	}
+	return res, nil
+}
+
+func (s *S) search(
+	ctx context.Context,
+	collectionName string,
+	expr string,
+	outputFields []string,
+	vs []entity.Vector,
+	vectorField string,
+	metricType entity.MetricType,
+	numDocuments int,
+	sp entity.SearchParam,
+) ([]*SearchResult, error) {
 	results, err := s.client.Search(
 		ctx,
 		collectionName,
 		nil, /* partitions */
-		"",  /* expr */
-		[]string{primaryKeyColName, fileIDColName, textColName},
+		expr,
+		outputFields,
 		vs,
-		vectorColName,
-		defaultMetricType,
+		vectorField,
+		metricType,
 		numDocuments,
 		sp,
 	)
@@ -197,17 +494,57 @@ func (s *S) Search(ctx context.Context, collectionName string, vectors []float32
 		return nil, err
 	}
 
-	var res []string
+	var res []*SearchResult
 	for _, r := range results {
 		// TODO(guangrui): Investigate the case when ResultCount is 0.
 		if r.ResultCount == 0 {
 			continue
 		}
+		ids, ok := r.IDs.(*entity.ColumnInt64)
+		if !ok {
+			return nil, fmt.Errorf("%s column missing", primaryKeyColName)
+		}
+		fileIDs, ok := r.Fields.GetColumn(fileIDColName).(*entity.ColumnVarChar)
+		if !ok {
+			return nil, fmt.Errorf("%s column missing", fileIDColName)
+		}
 		texts, ok := r.Fields.GetColumn(textColName).(*entity.ColumnVarChar)
 		if !ok {
 			return nil, fmt.Errorf("%s column missing", textColName)
 		}
-		res = append(res, texts.Data()...)
+		var metadata [][]byte
+		if col := r.Fields.GetColumn(metadataColName); col != nil {
+			mcol, ok := col.(*entity.ColumnJSONBytes)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type of %s column: %T", metadataColName, col)
+			}
+			metadata = mcol.Data()
+		}
+		for i := 0; i < r.ResultCount; i++ {
+			sr := &SearchResult{
+				ChunkID: ids.Data()[i],
+				FileID:  fileIDs.Data()[i],
+				Text:    texts.Data()[i],
+				Score:   toSimilarityScore(metricType, r.Scores[i]),
+			}
+			if metadata != nil {
+				var m ChunkMetadata
+				if err := json.Unmarshal(metadata[i], &m); err != nil {
+					return nil, fmt.Errorf("unmarshal metadata: %s", err)
+				}
+				sr.Metadata = &m
+			}
+			res = append(res, sr)
+		}
 	}
 	return res, nil
 }
+
+// toSimilarityScore converts a score returned by Milvus to a similarity score where a higher score means more similar.
+func toSimilarityScore(metricType entity.MetricType, score float32) float32 {
+	if metricType == entity.L2 {
+		// L2 returns a distance.
+		return 1 / (1 + score)
+	}
+	return score
+}
@@ -0,0 +1,50 @@
+package milvus
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextColumns(t *testing.T) {
+	// The term "omega" only appears after the cut.
+	text := strings.Repeat("alpha ", MaxTextBytes/6+1) + "omega"
+	cols, err := textColumns([]string{text, "short"}, true)
+	assert.NoError(t, err)
+	assert.Len(t, cols, 2)
+
+	texts := cols[0].(*entity.ColumnVarChar).Data()
+	assert.LessOrEqual(t, len(texts[0]), MaxTextBytes)
+	assert.True(t, strings.HasPrefix(text, texts[0]))
+	assert.NotContains(t, texts[0], "omega")
+	assert.Equal(t, "short", texts[1])
+
+	// The sparse vector is computed from the full text.
+	svs := cols[1].(*entity.ColumnSparseFloatVector).Data()
+	var found bool
+	for i := 0; i < svs[0].Len(); i++ {
+		p, _, ok := svs[0].Get(i)
+		assert.True(t, ok)
+		if p == termPosition("omega") {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	// Collections without the sparse field only have the text column.
+	cols, err = textColumns([]string{text}, false)
+	assert.NoError(t, err)
+	assert.Len(t, cols, 1)
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	assert.Equal(t, "abc", truncateUTF8("abc", 5))
+	assert.Equal(t, "ab", truncateUTF8("abc", 2))
+	// "あ" is 3 bytes.
+	assert.Equal(t, "aあ", truncateUTF8("aあい", 6))
+	assert.Equal(t, "a", truncateUTF8("aあい", 3))
+	assert.True(t, utf8.ValidString(truncateUTF8("aあい", 5)))
+}
@@ -0,0 +1,60 @@
+package milvus
+
+import "sort"
+
+// rrfK is the constant of reciprocal rank fusion. It dampens the impact of the top ranked documents of each ranking.
+const rrfK = 60
+
+// fuseRRF fuses rankings with reciprocal rank fusion and returns at most n results. The score of a fused result is
+// normalized to [0, 1] where 1 means that the document is ranked first in all of the rankings.
+func fuseRRF(rankings [][]*SearchResult, n int) []*SearchResult {
+	type entry struct {
+		r     *SearchResult
+		score float64
+		// rank is the best rank of the document and used to break ties.
+		rank int
+	}
+	entries := map[int64]*entry{}
+	var order []int64
+	for _, ranking := range rankings {
+		for i, r := range ranking {
+			e, ok := entries[r.ChunkID]
+			if !ok {
+				e = &entry{r: r, rank: i}
+				entries[r.ChunkID] = e
+				order = append(order, r.ChunkID)
+			}
+			e.score += 1 / float64(rrfK+i+1)
+			if i < e.rank {
+				e.rank = i
+			}
+		}
+	}
+	if len(rankings) == 0 {
+		return nil
+	}
+
+	maxScore := float64(len(rankings)) / (rrfK + 1)
+	var res []*SearchResult
+	for _, id := range order {
+		e := entries[id]
+		res = append(res, &SearchResult{
+			ChunkID:  e.r.ChunkID,
+			FileID:   e.r.FileID,
+			Text:     e.r.Text,
+			Score:    float32(e.score / maxScore),
+			Metadata: e.r.Metadata,
+		})
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		ei, ej := entries[res[i].ChunkID], entries[res[j].ChunkID]
+		if ei.score != ej.score {
+			return ei.score > ej.score
+		}
+		return ei.rank < ej.rank
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
@@ -0,0 +1,72 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuseRRF(t *testing.T) {
+	r := func(id int64) *SearchResult {
+		return &SearchResult{ChunkID: id}
+	}
+	tcs := []struct {
+		name      string
+		rankings  [][]*SearchResult
+		n         int
+		wantIDs   []int64
+		wantFirst float32
+	}{
+		{
+			name: "same ranking",
+			rankings: [][]*SearchResult{
+				{r(1), r(2)},
+				{r(1), r(2)},
+			},
+			n:         10,
+			wantIDs:   []int64{1, 2},
+			wantFirst: 1,
+		},
+		{
+			name: "documents in both rankings come first",
+			rankings: [][]*SearchResult{
+				{r(1), r(2), r(3)},
+				{r(3), r(4)},
+			},
+			n:       10,
+			wantIDs: []int64{3, 1, 2, 4},
+		},
+		{
+			name: "ties are broken by the best rank",
+			rankings: [][]*SearchResult{
+				{r(1), r(2)},
+				{r(3), r(4)},
+			},
+			n:       3,
+			wantIDs: []int64{1, 3, 2},
+		},
+		{
+			name: "empty ranking",
+			rankings: [][]*SearchResult{
+				{r(1), r(2)},
+				nil,
+			},
+			n:       10,
+			wantIDs: []int64{1, 2},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fuseRRF(tc.rankings, tc.n)
+			var ids []int64
+			for _, r := range got {
+				ids = append(ids, r.ChunkID)
+				assert.LessOrEqual(t, r.Score, float32(1))
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+			if tc.wantFirst > 0 {
+				assert.InDelta(t, tc.wantFirst, got[0].Score, 1e-6)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package milvus
+
+import (
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+const (
+	// bm25K1 controls the term frequency saturation of document weights.
+	bm25K1 = 1.2
+
+	// emptySparsePosition is the position used for texts without any term. Milvus rejects empty sparse vectors.
+	emptySparsePosition = 0
+	emptySparseValue    = 1e-6
+)
+
+var termSepRe = regexp.MustCompile(`[^\p{L}\p{N}_\-.]+`)
+
+// tokenize splits a text into lower-cased terms. Terms joined by '-', '_' or '.' (e.g., "e-1042") are kept as is,
+// and their parts are added as separate terms as well.
+func tokenize(text string) []string {
+	var terms []string
+	for _, w := range strings.Fields(termSepRe.ReplaceAllString(strings.ToLower(text), " ")) {
+		w = strings.Trim(w, "-_.")
+		if w == "" {
+			continue
+		}
+		terms = append(terms, w)
+		parts := strings.FieldsFunc(w, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+		if len(parts) > 1 {
+			terms = append(terms, parts...)
+		}
+	}
+	return terms
+}
+
+// termPosition maps a term to a dimension of the sparse vector.
+func termPosition(term string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(term))
+	// Reserve emptySparsePosition for texts without any term.
+	if p := h.Sum32(); p != emptySparsePosition {
+		return p
+	}
+	return 1
+}
+
+// documentSparseVector returns the sparse vector of a document. Each term is weighted by its BM25 term frequency
+// saturation. Document length normalization and IDF are not applied as they depend on the whole collection.
+func documentSparseVector(text string) (entity.SparseEmbedding, error) {
+	tfs := map[uint32]float32{}
+	for _, t := range tokenize(text) {
+		tfs[termPosition(t)]++
+	}
+	weights := map[uint32]float32{}
+	for p, tf := range tfs {
+		weights[p] = tf * (bm25K1 + 1) / (tf + bm25K1)
+	}
+	return toSparseEmbedding(weights)
+}
+
+// querySparseVector returns the sparse vector of a query. Each distinct term has the same weight so that the inner
+// product with a document vector is the sum of the weights of the matched terms.
+func querySparseVector(query string) (entity.SparseEmbedding, bool, error) {
+	weights := map[uint32]float32{}
+	for _, t := range tokenize(query) {
+		weights[termPosition(t)] = 1
+	}
+	if len(weights) == 0 {
+		return nil, false, nil
+	}
+	v, err := toSparseEmbedding(weights)
+	if err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+func toSparseEmbedding(weights map[uint32]float32) (entity.SparseEmbedding, error) {
+	if len(weights) == 0 {
+		weights = map[uint32]float32{emptySparsePosition: emptySparseValue}
+	}
+	var positions []uint32
+	for p := range weights {
+		positions = append(positions, p)
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+	values := make([]float32, len(positions))
+	for i, p := range positions {
+		values[i] = weights[p]
+	}
+	return entity.NewSliceSparseEmbedding(positions, values)
+}
@@ -0,0 +1,73 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tcs := []struct {
+		text string
+		want []string
+	}{
+		{
+			text: "Hello, World!",
+			want: []string{"hello", "world"},
+		},
+		{
+			text: "What is error code E-1042?",
+			want: []string{"what", "is", "error", "code", "e-1042", "e", "1042"},
+		},
+		{
+			text: "v1.2. -- done",
+			want: []string{"v1.2", "v1", "2", "done"},
+		},
+		{
+			text: " ?! ",
+			want: nil,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.text, func(t *testing.T) {
+			assert.Equal(t, tc.want, tokenize(tc.text))
+		})
+	}
+}
+
+func TestSparseVector(t *testing.T) {
+	doc, err := documentSparseVector("error error code")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, doc.Len())
+
+	// Repeated terms have a higher weight, which saturates.
+	errorPos, codePos := termPosition("error"), termPosition("code")
+	var errorWeight, codeWeight float32
+	for i := 0; i < doc.Len(); i++ {
+		p, v, ok := doc.Get(i)
+		assert.True(t, ok)
+		switch p {
+		case errorPos:
+			errorWeight = v
+		case codePos:
+			codeWeight = v
+		}
+	}
+	assert.InDelta(t, 1.0, codeWeight, 1e-6)
+	assert.Greater(t, errorWeight, codeWeight)
+	assert.Less(t, errorWeight, float32(bm25K1+1))
+
+	// A document without any term still has a non-empty vector.
+	doc, err = documentSparseVector("?!")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, doc.Len())
+
+	q, ok, err := querySparseVector("code code error")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, q.Len())
+
+	_, ok, err = querySparseVector("?!")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -1,10 +1,16 @@
 package ollama
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/ollama/ollama/api"
 )
 
@@ -15,13 +21,19 @@ func New(addr string) *Ollama {
 		Host:   addr,
 	}
 	return &Ollama{
-		client: api.NewClient(url, http.DefaultClient),
+		client:     api.NewClient(url, http.DefaultClient),
+		url:        url,
+		httpClient: http.DefaultClient,
 	}
 }
 
 // Ollama wraps the Ollama client.
 type Ollama struct {
 	client *api.Client
+
+	// url and httpClient are used for the APIs that the client does not support.
+	url        *url.URL
+	httpClient *http.Client
 }
 
 // Embed creates embeddings.
@@ -32,6 +44,15 @@ func (o *Ollama) Embed(ctx context.Context, modelName, prompt string) ([]float32
 	}
 	resp, err := o.client.Embeddings(ctx, &req)
 	if err != nil {
+		var serr api.StatusError
+		if errors.As(err, &serr) {
+			switch serr.StatusCode {
+			case http.StatusTooManyRequests:
+				return nil, fmt.Errorf("%w: %s", embedder.ErrRateLimitExceeded, err)
+			case http.StatusNotFound:
+				return nil, fmt.Errorf("%w: %s", embedder.ErrUnknownModel, err)
+			}
+		}
 		return nil, err
 	}
 
@@ -43,6 +64,71 @@ func (o *Ollama) Embed(ctx context.Context, modelName, prompt string) ([]float32
 	return es32, nil
 }
 
+type embedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type embedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+// EmbedBatch creates embeddings of multiple prompts in a single request with the /api/embed endpoint.
+// The prompts are embedded one by one if the Ollama server does not support the endpoint.
+func (o *Ollama) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	b, err := json.Marshal(&embedRequest{
+		Model: modelName,
+		Input: prompts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url.JoinPath("/api/embed").String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("new request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %s", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %s", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// The endpoint is not available before Ollama v0.3.0.
+		var es [][]float32
+		for _, p := range prompts {
+			e, err := o.Embed(ctx, modelName, p)
+			if err != nil {
+				return nil, err
+			}
+			es = append(es, e)
+		}
+		return es, nil
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("embed: %w: %s", embedder.ErrRateLimitExceeded, body)
+	default:
+		return nil, fmt.Errorf("embed: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	var eresp embedResponse
+	if err := json.Unmarshal(body, &eresp); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %s", err)
+	}
+	if len(eresp.Embeddings) != len(prompts) {
+		return nil, fmt.Errorf("embed: got %d embeddings for %d prompts", len(eresp.Embeddings), len(prompts))
+	}
+	return eresp.Embeddings, nil
+}
+
 // PullModel pulls a model.
 func (o *Ollama) PullModel(ctx context.Context, modelName string) error {
 	req := api.PullRequest{
@@ -2,7 +2,8 @@ package ollama
 
 import "fmt"
 
-// Dimension returns the dimension of the model.
+// Dimension returns the dimension of well-known models. It is used as a fallback when the dimension cannot be
+// discovered from the LLM engine.
 func Dimension(model string) (int, error) {
 	dimsByModel := map[string]int{
 		"all-minilm":       384,
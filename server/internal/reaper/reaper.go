@@ -0,0 +1,130 @@
+package reaper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+)
+
+type vstoreClient interface {
+	DeleteVectorStore(ctx context.Context, name string) error
+}
+
+// New creates a reaper.
+func New(
+	store *store.S,
+	vstoreClient vstoreClient,
+	cfg config.ExpirationConfig,
+	log logr.Logger,
+) *R {
+	return &R{
+		store:        store,
+		vstoreClient: vstoreClient,
+		cfg:          cfg,
+		log:          log.WithName("reaper"),
+	}
+}
+
+// R is a reaper that expires vector stores in the background.
+//
+// A vector store with an expiration policy expires once it has been inactive for the configured number of days.
+// The reaper keeps the expiration times of vector stores current, marks vector stores as expired once their
+// expiration times pass, and optionally drops the Milvus collections of expired vector stores.
+type R struct {
+	store        *store.S
+	vstoreClient vstoreClient
+	cfg          config.ExpirationConfig
+	log          logr.Logger
+}
+
+// Run periodically expires vector stores and blocks until the context is canceled.
+func (r *R) Run(ctx context.Context) error {
+	r.log.Info("Starting reaper...", "checkInterval", r.cfg.CheckInterval)
+	ticker := time.NewTicker(r.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.reap(ctx, time.Now()); err != nil {
+			r.log.Error(err, "Failed to expire vector stores")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// reap expires the vector stores whose expiration times have passed at the given time.
+func (r *R) reap(ctx context.Context, now time.Time) error {
+	cs, err := r.store.ListCollectionsWithExpiration()
+	if err != nil {
+		return fmt.Errorf("list collections: %s", err)
+	}
+	for _, c := range cs {
+		if err := r.reapCollection(ctx, c, now); err != nil {
+			return fmt.Errorf("collection %q: %s", c.VectorStoreID, err)
+		}
+	}
+	return nil
+}
+
+// reapCollection expires the vector store and drops its collection if configured. The expiration and the drop are
+// recorded with a version-checked update before the collection is dropped so that only one replica drops it.
+func (r *R) reapCollection(ctx context.Context, c *store.Collection, now time.Time) error {
+	log := r.log.WithValues("vectorStoreID", c.VectorStoreID)
+
+	drop := r.cfg.DropExpiredCollections && !c.VectorsDropped
+	if c.Status != store.CollectionStatusExpired {
+		expiresAt := c.ComputeExpiresAt()
+		if now.Unix() < expiresAt {
+			if c.ExpiresAt != expiresAt {
+				c.ExpiresAt = expiresAt
+				if err := r.store.UpdateCollectionExpiresAt(c); err != nil {
+					return fmt.Errorf("update expires at: %s", err)
+				}
+			}
+			return nil
+		}
+
+		c.VectorsDropped = c.VectorsDropped || drop
+		if err := r.store.ExpireCollection(c); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				// The vector store has become active or been updated by another replica. Check it again in the
+				// next round.
+				return nil
+			}
+			return err
+		}
+		c.Status = store.CollectionStatusExpired
+		log.Info("Expired vector store", "lastActiveAt", c.LastActiveAt, "expiresAfterDays", c.ExpiresAfterDays)
+	} else if drop {
+		c.VectorsDropped = true
+		if err := r.store.UpdateCollection(c); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				return nil
+			}
+			return fmt.Errorf("update collection: %s", err)
+		}
+	}
+	if !drop {
+		return nil
+	}
+	c.Version++
+
+	if err := r.vstoreClient.DeleteVectorStore(ctx, c.VectorStoreID); err != nil {
+		// Revert the update so that the collection is dropped in the next round.
+		c.VectorsDropped = false
+		if err := r.store.UpdateCollection(c); err != nil {
+			log.Error(err, "Failed to revert the drop of the collection")
+		}
+		return fmt.Errorf("drop vector store: %s", err)
+	}
+	log.Info("Dropped the collection of the expired vector store")
+	return nil
+}
@@ -0,0 +1,236 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	projectID = "project0"
+	day       = 24 * time.Hour
+)
+
+func TestReap(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	tcs := []struct {
+		name                   string
+		collection             *store.Collection
+		dropExpiredCollections bool
+		wantStatus             store.CollectionStatus
+		wantExpiresAt          int64
+		wantDropped            bool
+	}{
+		{
+			name: "active",
+			collection: &store.Collection{
+				Status:           store.CollectionStatusCompleted,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     now.Add(-6 * day).Unix(),
+			},
+			wantStatus:    store.CollectionStatusCompleted,
+			wantExpiresAt: now.Add(day).Unix(),
+		},
+		{
+			name: "expired",
+			collection: &store.Collection{
+				Status:           store.CollectionStatusCompleted,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     now.Add(-8 * day).Unix(),
+				ExpiresAt:        now.Add(-day).Unix(),
+			},
+			wantStatus:    store.CollectionStatusExpired,
+			wantExpiresAt: now.Add(-day).Unix(),
+		},
+		{
+			name: "expired and dropped",
+			collection: &store.Collection{
+				Status:           store.CollectionStatusCompleted,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     now.Add(-8 * day).Unix(),
+				ExpiresAt:        now.Add(-day).Unix(),
+			},
+			dropExpiredCollections: true,
+			wantStatus:             store.CollectionStatusExpired,
+			wantExpiresAt:          now.Add(-day).Unix(),
+			wantDropped:            true,
+		},
+		{
+			name: "already expired",
+			collection: &store.Collection{
+				Status:           store.CollectionStatusExpired,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     now.Add(-8 * day).Unix(),
+				ExpiresAt:        now.Add(-day).Unix(),
+				VectorsDropped:   true,
+			},
+			dropExpiredCollections: true,
+			wantStatus:             store.CollectionStatusExpired,
+			wantExpiresAt:          now.Add(-day).Unix(),
+			wantDropped:            true,
+		},
+		{
+			name: "no expiration",
+			collection: &store.Collection{
+				Status:       store.CollectionStatusCompleted,
+				LastActiveAt: now.Add(-365 * day).Unix(),
+			},
+			dropExpiredCollections: true,
+			wantStatus:             store.CollectionStatusCompleted,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			c := tc.collection
+			c.CollectionID = 1
+			c.VectorStoreID = "vs0"
+			c.Name = "collection0"
+			c.ProjectID = projectID
+			err := st.CreateCollection(c)
+			assert.NoError(t, err)
+
+			vs := &fakeVStoreClient{}
+			r := New(st, vs, config.ExpirationConfig{
+				Enable:                 true,
+				CheckInterval:          time.Minute,
+				DropExpiredCollections: tc.dropExpiredCollections,
+			}, testr.New(t))
+			err = r.reap(context.Background(), now)
+			assert.NoError(t, err)
+
+			got, err := st.GetCollectionByVectorStoreID(projectID, c.VectorStoreID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Status)
+			assert.Equal(t, tc.wantExpiresAt, got.ExpiresAt)
+			assert.Equal(t, tc.wantDropped, got.VectorsDropped)
+			// A collection is dropped only once.
+			if tc.wantDropped && !c.VectorsDropped {
+				assert.Equal(t, []string{c.VectorStoreID}, vs.deleted)
+			} else {
+				assert.Empty(t, vs.deleted)
+			}
+		})
+	}
+}
+
+func TestReap_Stale(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	tcs := []struct {
+		name   string
+		status store.CollectionStatus
+	}{
+		{
+			name:   "not expired",
+			status: store.CollectionStatusCompleted,
+		},
+		{
+			name:   "expired",
+			status: store.CollectionStatusExpired,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:     1,
+				VectorStoreID:    "vs0",
+				Name:             "collection0",
+				ProjectID:        projectID,
+				Status:           tc.status,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     now.Add(-8 * day).Unix(),
+				ExpiresAt:        now.Add(-day).Unix(),
+			})
+			assert.NoError(t, err)
+			cs, err := st.ListCollectionsWithExpiration()
+			assert.NoError(t, err)
+			assert.Len(t, cs, 1)
+
+			// Another replica has updated the collection after it was read.
+			cur, err := st.GetCollectionByVectorStoreID(projectID, "vs0")
+			assert.NoError(t, err)
+			cur.Status = store.CollectionStatusExpired
+			cur.VectorsDropped = true
+			err = st.UpdateCollection(cur)
+			assert.NoError(t, err)
+
+			vs := &fakeVStoreClient{}
+			r := New(st, vs, config.ExpirationConfig{
+				Enable:                 true,
+				CheckInterval:          time.Minute,
+				DropExpiredCollections: true,
+			}, testr.New(t))
+			err = r.reapCollection(context.Background(), cs[0], now)
+			assert.NoError(t, err)
+			assert.Empty(t, vs.deleted)
+		})
+	}
+}
+
+func TestReap_DropFailed(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:     1,
+		VectorStoreID:    "vs0",
+		Name:             "collection0",
+		ProjectID:        projectID,
+		Status:           store.CollectionStatusCompleted,
+		ExpiresAfterDays: 7,
+		LastActiveAt:     now.Add(-8 * day).Unix(),
+		ExpiresAt:        now.Add(-day).Unix(),
+	})
+	assert.NoError(t, err)
+
+	vs := &fakeVStoreClient{deleteErr: fmt.Errorf("milvus error")}
+	r := New(st, vs, config.ExpirationConfig{
+		Enable:                 true,
+		CheckInterval:          time.Minute,
+		DropExpiredCollections: true,
+	}, testr.New(t))
+	err = r.reap(context.Background(), now)
+	assert.Error(t, err)
+
+	got, err := st.GetCollectionByVectorStoreID(projectID, "vs0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.CollectionStatusExpired, got.Status)
+	assert.False(t, got.VectorsDropped)
+
+	// The collection is dropped in the next round.
+	vs.deleteErr = nil
+	err = r.reap(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vs0"}, vs.deleted)
+	got, err = st.GetCollectionByVectorStoreID(projectID, "vs0")
+	assert.NoError(t, err)
+	assert.True(t, got.VectorsDropped)
+}
+
+type fakeVStoreClient struct {
+	deleted   []string
+	deleteErr error
+}
+
+func (c *fakeVStoreClient) DeleteVectorStore(ctx context.Context, name string) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
+	c.deleted = append(c.deleted, name)
+	return nil
+}
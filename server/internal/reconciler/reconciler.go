@@ -0,0 +1,191 @@
+package reconciler
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+)
+
+// Metrics of the reconciler. They are exported at /debug/vars under "reconciler".
+var (
+	// orphanedCollections is the number of Milvus collections without vector stores found in the last run.
+	orphanedCollections = new(expvar.Int)
+	// missingCollections is the number of vector stores without Milvus collections found in the last run.
+	missingCollections = new(expvar.Int)
+	// droppedCollections is the total number of orphaned Milvus collections that have been dropped.
+	droppedCollections = new(expvar.Int)
+	// failures is the total number of failed runs.
+	failures = new(expvar.Int)
+	// lastReconciledAt is the Unix timestamp (in seconds) of the last successful run.
+	lastReconciledAt = new(expvar.Int)
+)
+
+func init() {
+	m := expvar.NewMap("reconciler")
+	m.Set("orphanedCollections", orphanedCollections)
+	m.Set("missingCollections", missingCollections)
+	m.Set("droppedCollections", droppedCollections)
+	m.Set("failures", failures)
+	m.Set("lastReconciledAt", lastReconciledAt)
+}
+
+type vstoreClient interface {
+	ListVectorStores(ctx context.Context) ([]string, error)
+	DeleteVectorStore(ctx context.Context, name string) error
+}
+
+// New creates a reconciler.
+func New(
+	store *store.S,
+	vstoreClient vstoreClient,
+	cfg config.ReconciliationConfig,
+	log logr.Logger,
+) *R {
+	return &R{
+		store:        store,
+		vstoreClient: vstoreClient,
+		cfg:          cfg,
+		log:          log.WithName("reconciler"),
+	}
+}
+
+// R is a reconciler that fixes inconsistencies between the vector stores in the database and the collections in
+// Milvus.
+//
+// Creating or deleting a vector store updates Milvus and the database separately, so a failure in between leaves a
+// collection without a vector store or a vector store without a collection. The reconciler drops the former once
+// they have been orphaned for the grace period and flags the latter. Only collections named after vector store IDs
+// are considered as the Milvus database might be shared with other tools. The time when each orphaned collection was
+// first found is stored in the database so that the grace period is kept across restarts and server replicas.
+type R struct {
+	store        *store.S
+	vstoreClient vstoreClient
+	cfg          config.ReconciliationConfig
+	log          logr.Logger
+}
+
+// Run periodically reconciles vector stores and blocks until the context is canceled.
+func (r *R) Run(ctx context.Context) error {
+	r.log.Info("Starting reconciler...", "interval", r.cfg.Interval, "dryRun", r.cfg.DryRun)
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.reconcile(ctx, time.Now()); err != nil {
+			failures.Add(1)
+			r.log.Error(err, "Failed to reconcile vector stores")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcile compares the vector stores with the collections at the given time.
+func (r *R) reconcile(ctx context.Context, now time.Time) error {
+	// List the Milvus collections before the vector stores in the database. A vector store is committed after its
+	// collection is created, so the collections of the vector stores created before now are listed.
+	names, err := r.vstoreClient.ListVectorStores(ctx)
+	if err != nil {
+		return fmt.Errorf("list vector stores: %s", err)
+	}
+	cs, err := r.store.ListAllCollections()
+	if err != nil {
+		return fmt.Errorf("list collections: %s", err)
+	}
+
+	exists := map[string]bool{}
+	for _, name := range names {
+		exists[name] = true
+	}
+	known := map[string]bool{}
+	var missing int64
+	for _, c := range cs {
+		known[c.VectorStoreID] = true
+		// The collection of an expired vector store might have been dropped on purpose. Vector stores created after
+		// the collections were listed are checked in the next run.
+		if c.VectorsDropped || c.CreatedAt.After(now) {
+			continue
+		}
+		found := exists[c.VectorStoreID]
+		if !found {
+			missing++
+		}
+		if found != c.VectorsMissing {
+			continue
+		}
+		log := r.log.WithValues("vectorStoreID", c.VectorStoreID, "dryRun", r.cfg.DryRun)
+		if found {
+			log.Info("Found the collection of the vector store")
+		} else {
+			log.Info("Collection of the vector store is missing")
+		}
+		if r.cfg.DryRun {
+			continue
+		}
+		if err := r.store.UpdateCollectionVectorsMissing(c.ID, !found); err != nil {
+			return fmt.Errorf("update collection %q: %s", c.VectorStoreID, err)
+		}
+	}
+	missingCollections.Set(missing)
+
+	ocs, err := r.store.ListOrphanedCollections()
+	if err != nil {
+		return fmt.Errorf("list orphaned collections: %s", err)
+	}
+	orphanedSince := map[string]time.Time{}
+	for _, oc := range ocs {
+		if !exists[oc.Name] || known[oc.Name] {
+			if err := r.store.DeleteOrphanedCollection(oc.Name); err != nil {
+				return fmt.Errorf("delete orphaned collection %q: %s", oc.Name, err)
+			}
+			continue
+		}
+		orphanedSince[oc.Name] = time.Unix(oc.FirstSeenAt, 0)
+	}
+	var orphaned int64
+	for _, name := range names {
+		if known[name] || !strings.HasPrefix(name, store.VectorStoreIDPrefix) {
+			continue
+		}
+		orphaned++
+		since, ok := orphanedSince[name]
+		if !ok {
+			since = now
+			if err := r.store.CreateOrphanedCollection(&store.OrphanedCollection{
+				Name:        name,
+				FirstSeenAt: now.Unix(),
+			}); err != nil {
+				return fmt.Errorf("create orphaned collection %q: %s", name, err)
+			}
+		}
+		if now.Sub(since) < r.cfg.GracePeriod {
+			continue
+		}
+		log := r.log.WithValues("collection", name, "orphanedSince", since, "dryRun", r.cfg.DryRun)
+		if r.cfg.DryRun {
+			log.Info("Found an orphaned collection")
+			continue
+		}
+		if err := r.vstoreClient.DeleteVectorStore(ctx, name); err != nil {
+			return fmt.Errorf("drop collection %q: %s", name, err)
+		}
+		if err := r.store.DeleteOrphanedCollection(name); err != nil {
+			return fmt.Errorf("delete orphaned collection %q: %s", name, err)
+		}
+		orphaned--
+		droppedCollections.Add(1)
+		log.Info("Dropped an orphaned collection")
+	}
+	orphanedCollections.Set(orphaned)
+	lastReconciledAt.Set(now.Unix())
+	return nil
+}
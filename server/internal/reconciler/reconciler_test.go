@@ -0,0 +1,214 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+const projectID = "project0"
+
+func TestReconcile(t *testing.T) {
+	const gracePeriod = time.Hour
+
+	tcs := []struct {
+		name        string
+		collections []*store.Collection
+		vstores     []string
+		dryRun      bool
+		wantMissing map[string]bool
+		// wantDropped are the collections dropped after the grace period.
+		wantDropped  []string
+		wantOrphaned int64
+	}{
+		{
+			name: "consistent",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+				{VectorStoreID: "vs_1"},
+			},
+			vstores:     []string{"vs_0", "vs_1"},
+			wantMissing: map[string]bool{"vs_0": false, "vs_1": false},
+		},
+		{
+			name: "orphaned",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+			},
+			vstores:     []string{"vs_0", "vs_1"},
+			wantMissing: map[string]bool{"vs_0": false},
+			wantDropped: []string{"vs_1"},
+		},
+		{
+			name: "orphaned in dry run",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+			},
+			vstores:      []string{"vs_0", "vs_1"},
+			dryRun:       true,
+			wantMissing:  map[string]bool{"vs_0": false},
+			wantOrphaned: 1,
+		},
+		{
+			name: "foreign collection",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+			},
+			vstores:     []string{"vs_0", "other_collection"},
+			wantMissing: map[string]bool{"vs_0": false},
+		},
+		{
+			name: "missing",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+				{VectorStoreID: "vs_1"},
+			},
+			vstores:     []string{"vs_0"},
+			wantMissing: map[string]bool{"vs_0": false, "vs_1": true},
+		},
+		{
+			name: "missing in dry run",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0"},
+				{VectorStoreID: "vs_1"},
+			},
+			vstores:     []string{"vs_0"},
+			dryRun:      true,
+			wantMissing: map[string]bool{"vs_0": false, "vs_1": false},
+		},
+		{
+			name: "found again",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0", VectorsMissing: true},
+			},
+			vstores:     []string{"vs_0"},
+			wantMissing: map[string]bool{"vs_0": false},
+		},
+		{
+			name: "dropped on expiration",
+			collections: []*store.Collection{
+				{VectorStoreID: "vs_0", Status: store.CollectionStatusExpired, VectorsDropped: true},
+			},
+			wantMissing: map[string]bool{"vs_0": false},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			for i, c := range tc.collections {
+				c.CollectionID = int64(i)
+				c.Name = c.VectorStoreID
+				c.ProjectID = projectID
+				err := st.CreateCollection(c)
+				assert.NoError(t, err)
+			}
+
+			vs := &fakeVStoreClient{names: tc.vstores}
+			r := New(st, vs, config.ReconciliationConfig{
+				Enable:      true,
+				Interval:    time.Minute,
+				GracePeriod: gracePeriod,
+				DryRun:      tc.dryRun,
+			}, testr.New(t))
+
+			// Orphaned collections are kept in the grace period.
+			now := time.Now()
+			err := r.reconcile(context.Background(), now)
+			assert.NoError(t, err)
+			assert.Empty(t, vs.deleted)
+
+			err = r.reconcile(context.Background(), now.Add(gracePeriod))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantDropped, vs.deleted)
+			assert.Equal(t, tc.wantOrphaned, orphanedCollections.Value())
+
+			for id, want := range tc.wantMissing {
+				c, err := st.GetCollectionByVectorStoreID(projectID, id)
+				assert.NoError(t, err)
+				assert.Equal(t, want, c.VectorsMissing, id)
+			}
+		})
+	}
+}
+
+func TestReconcile_CreatedAfterListing(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	now := time.Now()
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:  1,
+		VectorStoreID: "vs_0",
+		Name:          "vs_0",
+		ProjectID:     projectID,
+	})
+	assert.NoError(t, err)
+
+	vs := &fakeVStoreClient{}
+	r := New(st, vs, config.ReconciliationConfig{
+		Enable:      true,
+		Interval:    time.Minute,
+		GracePeriod: time.Hour,
+	}, testr.New(t))
+	// The vector store has been created after the collections were listed.
+	err = r.reconcile(context.Background(), now.Add(-time.Second))
+	assert.NoError(t, err)
+
+	c, err := st.GetCollectionByVectorStoreID(projectID, "vs_0")
+	assert.NoError(t, err)
+	assert.False(t, c.VectorsMissing)
+}
+
+func TestReconcile_Restarted(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	const gracePeriod = time.Hour
+	cfg := config.ReconciliationConfig{
+		Enable:      true,
+		Interval:    time.Minute,
+		GracePeriod: gracePeriod,
+	}
+	vs := &fakeVStoreClient{names: []string{"vs_0"}}
+	now := time.Now()
+	err := New(st, vs, cfg, testr.New(t)).reconcile(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Empty(t, vs.deleted)
+
+	// The grace period is kept by another reconciler, e.g., after the server is restarted.
+	err = New(st, vs, cfg, testr.New(t)).reconcile(context.Background(), now.Add(gracePeriod))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vs_0"}, vs.deleted)
+
+	ocs, err := st.ListOrphanedCollections()
+	assert.NoError(t, err)
+	assert.Empty(t, ocs)
+}
+
+type fakeVStoreClient struct {
+	names   []string
+	deleted []string
+}
+
+func (c *fakeVStoreClient) ListVectorStores(ctx context.Context) ([]string, error) {
+	return c.names, nil
+}
+
+func (c *fakeVStoreClient) DeleteVectorStore(ctx context.Context, name string) error {
+	c.deleted = append(c.deleted, name)
+	var names []string
+	for _, n := range c.names {
+		if n != name {
+			names = append(names, n)
+		}
+	}
+	c.names = names
+	return nil
+}
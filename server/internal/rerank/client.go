@@ -0,0 +1,105 @@
+package rerank
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+)
+
+// NewClient creates a new client for a rerank endpoint compatible with the Cohere rerank API.
+// vLLM and most cross-encoder servers (e.g., Text Embeddings Inference) serve the same API.
+func NewClient(baseURL, model, apiKey string, log logr.Logger) *Client {
+	return &Client{
+		url:        strings.TrimSuffix(baseURL, "/") + "/rerank",
+		model:      model,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		log:        log.WithName("rerank"),
+	}
+}
+
+// Client is a client for a rerank endpoint.
+type Client struct {
+	url        string
+	model      string
+	apiKey     string
+	httpClient *http.Client
+	log        logr.Logger
+}
+
+type rerankRequest struct {
+	Model     string   `json:"model"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	TopN      int      `json:"top_n"`
+}
+
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float32 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+// Rerank scores the relevance of each document to the query.
+func (c *Client) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	b, err := json.Marshal(&rerankRequest{
+		Model:     c.model,
+		Query:     query,
+		Documents: documents,
+		TopN:      len(documents),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("new request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("rerank: %s", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %s", err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("rerank: %w: %s", embedder.ErrRateLimitExceeded, body)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rerank: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	var rresp rerankResponse
+	if err := json.Unmarshal(body, &rresp); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %s", err)
+	}
+	if len(rresp.Results) != len(documents) {
+		return nil, fmt.Errorf("rerank: got %d scores for %d documents", len(rresp.Results), len(documents))
+	}
+	scores := make([]float32, len(documents))
+	for _, r := range rresp.Results {
+		if r.Index < 0 || r.Index >= len(documents) {
+			return nil, fmt.Errorf("rerank: invalid document index %d", r.Index)
+		}
+		scores[r.Index] = r.RelevanceScore
+	}
+	c.log.V(1).Info("Reranked documents", "numDocuments", len(documents))
+	return scores, nil
+}
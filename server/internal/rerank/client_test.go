@@ -0,0 +1,51 @@
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerank(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/rerank", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+
+		var req rerankRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "model", req.Model)
+		assert.Equal(t, "query", req.Query)
+
+		// Results are sorted by relevance.
+		_, err = w.Write([]byte(`{"results": [{"index": 1, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}]}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL+"/v1/", "model", "key", testr.New(t))
+	scores, err := c.Rerank(context.Background(), "query", []string{"doc0", "doc1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.9}, scores)
+
+	_, err = c.Rerank(context.Background(), "query", []string{"doc0"})
+	assert.Error(t, err)
+}
+
+func TestRerank_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "model", "", testr.New(t))
+	_, err := c.Rerank(context.Background(), "query", []string{"doc0"})
+	assert.True(t, errors.Is(err, embedder.ErrRateLimitExceeded))
+}
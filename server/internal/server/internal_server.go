@@ -7,18 +7,29 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 type retriever interface {
-	Search(ctx context.Context, collectionName, modelName, query string, numDocs int) ([]string, error)
+	Search(
+		ctx context.Context,
+		collectionName string,
+		index milvus.IndexConfig,
+		modelName, query string,
+		numDocs int,
+		filter *milvus.Filter,
+		mode milvus.SearchMode,
+		rerank bool,
+	) ([]*milvus.SearchResult, error)
 }
 
 // NewInternal creates an internal server.
-func NewInternal(model string, r retriever, log logr.Logger) *IS {
+func NewInternal(store *store.S, r retriever, log logr.Logger) *IS {
 	return &IS{
-		model:     model,
+		store:     store,
 		retriever: r,
 		log:       log.WithName("internal"),
 	}
@@ -28,7 +39,7 @@ func NewInternal(model string, r retriever, log logr.Logger) *IS {
 type IS struct {
 	v1.UnimplementedVectorStoreInternalServiceServer
 
-	model     string
+	store     *store.S
 	retriever retriever
 	srv       *grpc.Server
 	log       logr.Logger
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// validateVectorStoreQuotaInTransaction checks if a vector store can be created in the project. The project is locked
+// until the transaction ends so that concurrent requests cannot exceed the quota.
+func (s *S) validateVectorStoreQuotaInTransaction(tx *gorm.DB, projectID string) error {
+	q := s.quotas.ForProject(projectID)
+	if q.MaxVectorStores == 0 {
+		return nil
+	}
+	if err := store.LockProjectInTransaction(tx, projectID); err != nil {
+		return fmt.Errorf("lock project: %s", err)
+	}
+	n, err := store.CountCollectionsInTransaction(tx, projectID)
+	if err != nil {
+		return fmt.Errorf("count collections: %s", err)
+	}
+	if n >= q.MaxVectorStores {
+		return status.Errorf(codes.ResourceExhausted, "the project has reached its quota of %d vector stores", q.MaxVectorStores)
+	}
+	return nil
+}
+
+// validateFileQuotaInTransaction checks if numFiles files can be added to the vector store. The project is locked
+// until the transaction ends so that concurrent requests cannot exceed the quota.
+func (s *S) validateFileQuotaInTransaction(tx *gorm.DB, projectID, vectorStoreID string, numFiles int64) error {
+	q := s.quotas.ForProject(projectID)
+	if q.MaxFilesPerVectorStore == 0 && q.MaxUsageBytes == 0 {
+		return nil
+	}
+	if err := store.LockProjectInTransaction(tx, projectID); err != nil {
+		return fmt.Errorf("lock project: %s", err)
+	}
+	if q.MaxFilesPerVectorStore > 0 {
+		c, err := store.GetCollectionByVectorStoreIDInTransaction(tx, projectID, vectorStoreID)
+		if err != nil {
+			return fmt.Errorf("get collection: %s", err)
+		}
+		if c.FileCountsTotal+numFiles > q.MaxFilesPerVectorStore {
+			return status.Errorf(codes.ResourceExhausted, "a vector store cannot have more than %d files", q.MaxFilesPerVectorStore)
+		}
+	}
+	if q.MaxUsageBytes == 0 {
+		return nil
+	}
+	usage, err := store.GetTotalUsageBytesInTransaction(tx, projectID)
+	if err != nil {
+		return fmt.Errorf("get total usage bytes: %s", err)
+	}
+	if usage >= q.MaxUsageBytes {
+		return status.Errorf(codes.ResourceExhausted, "the project has used %d bytes, reaching its quota of %d bytes", usage, q.MaxUsageBytes)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVectorStoreQuota(t *testing.T) {
+	tcs := []struct {
+		name     string
+		quotas   config.QuotasConfig
+		wantCode codes.Code
+	}{
+		{
+			name:     "no quota",
+			wantCode: codes.OK,
+		},
+		{
+			name: "default quota",
+			quotas: config.QuotasConfig{
+				Default: config.QuotaConfig{MaxVectorStores: 1},
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name: "project override",
+			quotas: config.QuotasConfig{
+				Default: config.QuotaConfig{MaxVectorStores: 1},
+				Projects: map[string]config.QuotaConfig{
+					"default": {MaxVectorStores: 2},
+				},
+			},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			srv := New(
+				st,
+				&noopFileGetClient{},
+				&noopVStoreClient{vs: map[string]int64{}},
+				&noopEmbedder{},
+				modelName,
+				testModels,
+				tc.quotas,
+				testr.New(t),
+			)
+			ctx := fakeAuthInto(context.Background())
+			_, err := srv.CreateVectorStore(ctx, &v1.CreateVectorStoreRequest{Name: "vs0"})
+			assert.NoError(t, err)
+
+			_, err = srv.CreateVectorStore(ctx, &v1.CreateVectorStoreRequest{Name: "vs1"})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestFileQuota(t *testing.T) {
+	tcs := []struct {
+		name       string
+		quota      config.QuotaConfig
+		usageBytes int64
+		// wantCode and wantBatchCode are the codes of adding a file and then a file batch.
+		wantCode      codes.Code
+		wantBatchCode codes.Code
+	}{
+		{
+			name:          "no quota",
+			wantCode:      codes.OK,
+			wantBatchCode: codes.OK,
+		},
+		{
+			name:          "max files",
+			quota:         config.QuotaConfig{MaxFilesPerVectorStore: 1},
+			wantCode:      codes.OK,
+			wantBatchCode: codes.ResourceExhausted,
+		},
+		{
+			name:          "within usage",
+			quota:         config.QuotaConfig{MaxUsageBytes: 1000},
+			usageBytes:    999,
+			wantCode:      codes.OK,
+			wantBatchCode: codes.OK,
+		},
+		{
+			name:          "usage exceeded",
+			quota:         config.QuotaConfig{MaxUsageBytes: 1000},
+			usageBytes:    1000,
+			wantCode:      codes.ResourceExhausted,
+			wantBatchCode: codes.ResourceExhausted,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			srv := New(
+				st,
+				&noopFileGetClient{
+					ids: map[string]string{
+						"file0": fileName,
+						"file1": fileName,
+					},
+				},
+				&noopVStoreClient{vs: map[string]int64{vectorStoreID: collectionID}},
+				&noopEmbedder{},
+				modelName,
+				testModels,
+				config.QuotasConfig{Default: tc.quota},
+				testr.New(t),
+			)
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:  collectionID,
+				VectorStoreID: vectorStoreID,
+				Name:          collectionName,
+				Status:        store.CollectionStatusCompleted,
+				ProjectID:     "default",
+				UsageBytes:    tc.usageBytes,
+			})
+			assert.NoError(t, err)
+
+			ctx := fakeAuthInto(context.Background())
+			_, err = srv.CreateVectorStoreFile(ctx, &v1.CreateVectorStoreFileRequest{
+				FileId:        "file0",
+				VectorStoreId: vectorStoreID,
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+
+			_, err = srv.CreateVectorStoreFileBatch(ctx, &v1.CreateVectorStoreFileBatchRequest{
+				FileIds:       []string{"file1"},
+				VectorStoreId: vectorStoreID,
+			})
+			assert.Equal(t, tc.wantBatchCode, status.Code(err))
+		})
+	}
+}
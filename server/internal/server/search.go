@@ -2,17 +2,181 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	embed "github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 const (
 	defaultNumDocuments = 10
 	maxNumDocuments     = 100
+
+	vectorStoreSearchResultsObject = "vector_store.search_results.page"
+
+	defaultMaxNumResults = 10
+	maxMaxNumResults     = 50
+
+	rankerAuto    = "auto"
+	rankerDefault = "default-2024-11-15"
+
+	searchResultContentTypeText = "text"
+)
+
+// SearchVectorStore searches a vector store for chunks relevant to the given query.
+func (s *S) SearchVectorStore(
+	ctx context.Context,
+	req *v1.VectorStoreSearchRequest,
+) (*v1.VectorStoreSearchResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if req.MaxNumResults < 0 || req.MaxNumResults > maxMaxNumResults {
+		return nil, status.Errorf(codes.InvalidArgument, "max_num_results must be between 1 and %d", maxMaxNumResults)
+	}
+	var scoreThreshold float32
+	var rerank bool
+	if ro := req.RankingOptions; ro != nil {
+		if ro.Ranker != "" && ro.Ranker != rankerAuto && ro.Ranker != rankerDefault {
+			return nil, status.Errorf(codes.InvalidArgument, "ranking_options.ranker must be one of %q or %q", rankerAuto, rankerDefault)
+		}
+		if ro.ScoreThreshold < 0 || ro.ScoreThreshold > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "ranking_options.score_threshold must be between 0 and 1")
+		}
+		scoreThreshold = ro.ScoreThreshold
+		rerank = ro.Rerank
+	}
+	filter, err := toFilter(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := toSearchMode(req.SearchMode)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+	if err := validateNotExpired(c); err != nil {
+		return nil, err
+	}
+	if err := markActive(s.store, c); err != nil {
+		return nil, err
+	}
+
+	maxNumResults := int(req.MaxNumResults)
+	if maxNumResults == 0 {
+		maxNumResults = defaultMaxNumResults
+	}
+
+	query := req.Query
+	if req.RewriteQuery {
+		query = removeEnglishStopWords(query)
+	}
+
+	results, err := s.embedder.Search(ctx, c.VectorStoreID, indexConfig(c), c.EmbeddingModel, query, maxNumResults, filter, mode, rerank)
+	if err != nil {
+		return nil, toSearchError(err)
+	}
+	filenames, err := getFilenames(s.store, c.VectorStoreID, results)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []*v1.VectorStoreSearchResult{}
+	for _, r := range results {
+		if r.Score < scoreThreshold {
+			continue
+		}
+		data = append(data, &v1.VectorStoreSearchResult{
+			FileId:   r.FileID,
+			Filename: filenames[r.FileID],
+			Score:    r.Score,
+			Content: []*v1.VectorStoreSearchResult_Content{
+				{
+					Type: searchResultContentTypeText,
+					Text: r.Text,
+				},
+			},
+			Metadata: toChunkMetadataProto(r.Metadata),
+		})
+	}
+	return &v1.VectorStoreSearchResponse{
+		Object:      vectorStoreSearchResultsObject,
+		SearchQuery: query,
+		Data:        data,
+		HasMore:     false,
+	}, nil
+}
+
+var (
+	nonWordRe = regexp.MustCompile(`[^\p{L}\p{N}_\-.]+`)
+
+	// englishStopWords are English words that carry little meaning for vector search.
+	englishStopWords = map[string]bool{
+		"a": true, "an": true, "the": true, "is": true, "are": true, "was": true, "were": true,
+		"do": true, "does": true, "did": true, "can": true, "could": true, "please": true,
+		"what": true, "which": true, "who": true, "how": true, "why": true, "when": true, "where": true,
+		"i": true, "me": true, "my": true, "you": true, "tell": true, "about": true,
+		"of": true, "to": true, "in": true, "on": true, "for": true,
+	}
 )
 
+// removeEnglishStopWords rewrites an English query into keywords for vector search when the query rewrite is
+// requested. Punctuation and English stop words are removed. Queries that contain quoted phrases or non-ASCII letters
+// are returned as is as the rewrite would damage phrases and queries in other languages. The original query is also
+// returned if nothing remains.
+func removeEnglishStopWords(query string) string {
+	if strings.Contains(query, `"`) || !isASCII(query) {
+		return query
+	}
+	var words []string
+	for _, w := range strings.Fields(nonWordRe.ReplaceAllString(strings.ToLower(query), " ")) {
+		w = strings.Trim(w, "-.")
+		if w == "" || englishStopWords[w] {
+			continue
+		}
+		words = append(words, w)
+	}
+	if len(words) == 0 {
+		return query
+	}
+	return strings.Join(words, " ")
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
 // SearchVectorStore searches documents for the given query from a vector store.
 func (s *IS) SearchVectorStore(
 	ctx context.Context,
@@ -30,6 +194,39 @@ func (s *IS) SearchVectorStore(
 		return nil, status.Errorf(codes.InvalidArgument, "num_documents must be non-negative")
 	}
 
+	filter, err := toFilter(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := toSearchMode(req.SearchMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ProjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreID(req.ProjectId, req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+	if c.TenantID != req.TenantId {
+		return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+	}
+	if err := validateNotExpired(c); err != nil {
+		return nil, err
+	}
+	if err := markActive(s.store, c); err != nil {
+		return nil, err
+	}
+
 	numDocs := int(req.NumDocuments)
 	if numDocs == 0 {
 		numDocs = defaultNumDocuments
@@ -38,11 +235,116 @@ func (s *IS) SearchVectorStore(
 		numDocs = maxNumDocuments
 	}
 
-	docs, err := s.retriever.Search(ctx, req.VectorStoreId, s.model, req.Query, numDocs)
+	results, err := s.retriever.Search(ctx, c.VectorStoreID, indexConfig(c), c.EmbeddingModel, req.Query, numDocs, filter, mode, req.Rerank)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "search vector store: %s", err)
+		return nil, toSearchError(err)
+	}
+	filenames, err := getFilenames(s.store, c.VectorStoreID, results)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []string
+	var protos []*v1.SearchResult
+	for _, r := range results {
+		docs = append(docs, r.Text)
+		protos = append(protos, &v1.SearchResult{
+			ChunkId:  strconv.FormatInt(r.ChunkID, 10),
+			FileId:   r.FileID,
+			Filename: filenames[r.FileID],
+			Score:    r.Score,
+			Text:     r.Text,
+			Metadata: toChunkMetadataProto(r.Metadata),
+		})
 	}
 	return &v1.SearchVectorStoreResponse{
 		Documents: docs,
+		Results:   protos,
 	}, nil
 }
+
+// toChunkMetadataProto converts the metadata of a chunk. It returns nil if the chunk does not have metadata.
+func toChunkMetadataProto(m *milvus.ChunkMetadata) *v1.ChunkMetadata {
+	if m == nil {
+		return nil
+	}
+	return &v1.ChunkMetadata{
+		Page:        int32(m.Page),
+		Headings:    m.Headings,
+		ChunkIndex:  int32(m.ChunkIndex),
+		StartOffset: int32(m.StartOffset),
+		EndOffset:   int32(m.EndOffset),
+	}
+}
+
+func toSearchError(err error) error {
+	if errors.Is(err, embed.ErrRerankerNotConfigured) {
+		return status.Errorf(codes.FailedPrecondition, "reranking is not enabled")
+	}
+	return status.Errorf(codes.Internal, "search vector store: %s", err)
+}
+
+// toFilter converts a filter in a request to a filter on document attributes. It returns nil if no filter is specified.
+func toFilter(f *v1.Filter) (*milvus.Filter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	filter := convertFilter(f)
+	if _, err := filter.Expr(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filters: %s", err)
+	}
+	return filter, nil
+}
+
+// toSearchMode converts a search mode in a request. Dense search is used if no mode is specified.
+func toSearchMode(mode string) (milvus.SearchMode, error) {
+	switch m := milvus.SearchMode(mode); m {
+	case "":
+		return milvus.SearchModeDense, nil
+	case milvus.SearchModeDense, milvus.SearchModeSparse, milvus.SearchModeHybrid:
+		return m, nil
+	default:
+		return "", status.Errorf(
+			codes.InvalidArgument,
+			"search_mode must be one of %q, %q or %q",
+			milvus.SearchModeDense,
+			milvus.SearchModeSparse,
+			milvus.SearchModeHybrid,
+		)
+	}
+}
+
+func convertFilter(f *v1.Filter) *milvus.Filter {
+	filter := &milvus.Filter{
+		Type:  milvus.FilterType(f.Type),
+		Key:   f.Key,
+		Value: f.Value,
+	}
+	for _, sf := range f.Filters {
+		filter.Filters = append(filter.Filters, convertFilter(sf))
+	}
+	return filter
+}
+
+// getFilenames returns the names of the files that matched chunks belong to, keyed by file ID. The name is empty
+// if the file has already been removed from the vector store.
+func getFilenames(st *store.S, vectorStoreID string, results []*milvus.SearchResult) (map[string]string, error) {
+	var fileIDs []string
+	seen := map[string]bool{}
+	for _, r := range results {
+		if seen[r.FileID] {
+			continue
+		}
+		seen[r.FileID] = true
+		fileIDs = append(fileIDs, r.FileID)
+	}
+	fs, err := st.ListFilesByFileIDs(vectorStoreID, fileIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list files: %s", err)
+	}
+	filenames := map[string]string{}
+	for _, f := range fs {
+		filenames[f.FileID] = f.Filename
+	}
+	return filenames, nil
+}
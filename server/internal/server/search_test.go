@@ -4,75 +4,561 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSearchVectorStore(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.SearchVectorStoreRequest
-		resp    *v1.SearchVectorStoreResponse
-		wantErr bool
+		name     string
+		req      *v1.SearchVectorStoreRequest
+		resp     *v1.SearchVectorStoreResponse
+		wantCode codes.Code
 	}{
 		{
 			name: "found",
 			req: &v1.SearchVectorStoreRequest{
 				VectorStoreId: vectorStoreName,
 				Query:         "hi",
+				ProjectId:     defaultProjectID,
+				TenantId:      defaultTenantID,
 			},
 			resp: &v1.SearchVectorStoreResponse{
 				Documents: []string{
 					"hello",
 					"hi",
 				},
+				Results: []*v1.SearchResult{
+					{
+						ChunkId:  "1",
+						FileId:   fileID,
+						Filename: fileName,
+						Score:    0.9,
+						Text:     "hello",
+						Metadata: &v1.ChunkMetadata{
+							Page:        3,
+							Headings:    []string{"Intro"},
+							ChunkIndex:  4,
+							StartOffset: 10,
+							EndOffset:   15,
+						},
+					},
+					{
+						ChunkId: "2",
+						FileId:  "deleted",
+						Score:   0.8,
+						Text:    "hi",
+					},
+				},
 			},
-			wantErr: false,
+			wantCode: codes.OK,
 		},
 		{
-			name: "not found",
+			name: "no documents",
 			req: &v1.SearchVectorStoreRequest{
 				VectorStoreId: vectorStoreName,
 				Query:         "unknown",
+				ProjectId:     defaultProjectID,
+				TenantId:      defaultTenantID,
 			},
 			resp: &v1.SearchVectorStoreResponse{
 				Documents: nil,
 			},
-			wantErr: false,
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid search mode",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				ProjectId:     defaultProjectID,
+				TenantId:      defaultTenantID,
+				SearchMode:    "unknown",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "no project",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				TenantId:      defaultTenantID,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown vector store",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: "unknown",
+				Query:         "hi",
+				ProjectId:     defaultProjectID,
+				TenantId:      defaultTenantID,
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "different project",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				ProjectId:     "other",
+				TenantId:      defaultTenantID,
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "different tenant",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				ProjectId:     defaultProjectID,
+				TenantId:      "other",
+			},
+			wantCode: codes.NotFound,
 		},
 	}
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:   collectionID,
+				VectorStoreID:  vectorStoreName,
+				Name:           collectionName,
+				ProjectID:      defaultProjectID,
+				TenantID:       defaultTenantID,
+				EmbeddingModel: "multilingual",
+			})
+			assert.NoError(t, err)
+			err = st.CreateFile(&store.File{
+				FileID:        fileID,
+				VectorStoreID: vectorStoreName,
+				Filename:      fileName,
+				Status:        store.FileStatusCompleted,
+			})
+			assert.NoError(t, err)
 
 			srv := NewInternal(
-				modelName,
+				st,
 				&noopRetriever{
 					collectionName: vectorStoreName,
-					docs: map[string][]string{
-						"hi": []string{"hello", "hi"},
+					modelName:      "multilingual",
+					docs: map[string][]*milvus.SearchResult{
+						"hi": {
+							{
+								ChunkID: 1,
+								FileID:  fileID,
+								Text:    "hello",
+								Score:   0.9,
+								Metadata: &milvus.ChunkMetadata{
+									Page:        3,
+									Headings:    []string{"Intro"},
+									ChunkIndex:  4,
+									StartOffset: 10,
+									EndOffset:   15,
+								},
+							},
+							{ChunkID: 2, FileID: "deleted", Text: "hi", Score: 0.8},
+						},
 					},
 				},
 				testr.New(t),
 			)
 			ctx := context.Background()
 			resp, err := srv.SearchVectorStore(ctx, tc.req)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.resp.Documents, resp.Documents)
+			assert.Equal(t, len(tc.resp.Results), len(resp.Results))
+			for i, r := range tc.resp.Results {
+				assert.Equal(t, r.ChunkId, resp.Results[i].ChunkId)
+				assert.Equal(t, r.FileId, resp.Results[i].FileId)
+				assert.Equal(t, r.Filename, resp.Results[i].Filename)
+				assert.Equal(t, r.Score, resp.Results[i].Score)
+				assert.Equal(t, r.Text, resp.Results[i].Text)
+			}
+		})
+	}
+}
+
+func TestSearchVectorStore_Expiration(t *testing.T) {
+	tcs := []struct {
+		name     string
+		status   store.CollectionStatus
+		wantCode codes.Code
+	}{
+		{
+			name:     "active",
+			status:   store.CollectionStatusCompleted,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "expired",
+			status:   store.CollectionStatusExpired,
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			lastActiveAt := time.Now().Add(-time.Hour).Unix()
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:     collectionID,
+				VectorStoreID:    vectorStoreName,
+				Name:             collectionName,
+				ProjectID:        defaultProjectID,
+				TenantID:         defaultTenantID,
+				EmbeddingModel:   "multilingual",
+				Status:           tc.status,
+				ExpiresAfterDays: 7,
+				LastActiveAt:     lastActiveAt,
+			})
+			assert.NoError(t, err)
+
+			srv := NewInternal(
+				st,
+				&noopRetriever{
+					collectionName: vectorStoreName,
+					modelName:      "multilingual",
+				},
+				testr.New(t),
+			)
+			_, err = srv.SearchVectorStore(context.Background(), &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				ProjectId:     defaultProjectID,
+				TenantId:      defaultTenantID,
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+
+			c, err := st.GetCollectionByVectorStoreID(defaultProjectID, vectorStoreName)
 			assert.NoError(t, err)
-			assert.Equal(t, len(tc.resp.Documents), len(resp.Documents))
+			if tc.wantCode == codes.OK {
+				// The search extends the expiration time.
+				assert.Greater(t, c.LastActiveAt, lastActiveAt)
+				assert.Equal(t, c.LastActiveAt+7*24*60*60, c.ExpiresAt)
+			} else {
+				assert.Equal(t, lastActiveAt, c.LastActiveAt)
+			}
+		})
+	}
+}
+
+func TestSearchVectorStore_Public(t *testing.T) {
+	tcs := []struct {
+		name     string
+		req      *v1.VectorStoreSearchRequest
+		wantIDs  []string
+		wantExpr string
+		wantMode milvus.SearchMode
+		// disableRerank is true if the server is not configured with a reranker.
+		disableRerank bool
+		wantRerank    bool
+		wantCode      codes.Code
+	}{
+		{
+			name: "success",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+			},
+			wantIDs:  []string{fileID, "file1"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "max num results",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				MaxNumResults: 1,
+			},
+			wantIDs:  []string{fileID},
+			wantCode: codes.OK,
+		},
+		{
+			name: "score threshold",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				RankingOptions: &v1.VectorStoreSearchRequest_RankingOptions{
+					Ranker:         "auto",
+					ScoreThreshold: 0.5,
+				},
+			},
+			wantIDs:  []string{fileID},
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid max num results",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				MaxNumResults: 51,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid ranker",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				RankingOptions: &v1.VectorStoreSearchRequest_RankingOptions{
+					Ranker: "unknown",
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "filters",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				Filters: &v1.Filter{
+					Type: "and",
+					Filters: []*v1.Filter{
+						{Type: "eq", Key: "lang", Value: "en"},
+						{Type: "gte", Key: "year", Value: "2024"},
+					},
+				},
+			},
+			wantIDs:  []string{fileID, "file1"},
+			wantExpr: `(attributes["lang"] == "en" and attributes["year"] >= 2024)`,
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid filters",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				Filters: &v1.Filter{
+					Type: "and",
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "too deep filters",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				Filters: &v1.Filter{Type: "and", Filters: []*v1.Filter{
+					{Type: "and", Filters: []*v1.Filter{
+						{Type: "and", Filters: []*v1.Filter{
+							{Type: "and", Filters: []*v1.Filter{
+								{Type: "and", Filters: []*v1.Filter{
+									{Type: "eq", Key: "lang", Value: "en"},
+								}},
+							}},
+						}},
+					}},
+				}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "hybrid search",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				SearchMode:    "hybrid",
+			},
+			wantIDs:  []string{fileID, "file1"},
+			wantMode: milvus.SearchModeHybrid,
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid search mode",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				SearchMode:    "unknown",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "rerank",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				RankingOptions: &v1.VectorStoreSearchRequest_RankingOptions{
+					Rerank: true,
+				},
+			},
+			wantIDs:    []string{fileID, "file1"},
+			wantRerank: true,
+			wantCode:   codes.OK,
+		},
+		{
+			name: "rerank not enabled",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hello",
+				RankingOptions: &v1.VectorStoreSearchRequest_RankingOptions{
+					Rerank: true,
+				},
+			},
+			disableRerank: true,
+			wantCode:      codes.FailedPrecondition,
+		},
+		{
+			name: "vector store in another project",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: "vs_other",
+				Query:         "hello",
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			for _, c := range []*store.Collection{
+				{CollectionID: 1, VectorStoreID: vectorStoreID, Name: "c0", ProjectID: defaultProjectID},
+				{CollectionID: 2, VectorStoreID: "vs_other", Name: "c1", ProjectID: "other"},
+			} {
+				err := st.CreateCollection(c)
+				assert.NoError(t, err)
+			}
+			err := st.CreateFile(&store.File{
+				FileID:        fileID,
+				VectorStoreID: vectorStoreID,
+				Filename:      fileName,
+				Status:        store.FileStatusCompleted,
+			})
+			assert.NoError(t, err)
+
+			e := &noopEmbedder{
+				results: []*milvus.SearchResult{
+					{ChunkID: 1, FileID: fileID, Text: "hello", Score: 0.9},
+					{ChunkID: 2, FileID: "file1", Text: "hi", Score: 0.2},
+				},
+				enableRerank: !tc.disableRerank,
+			}
+			srv := New(
+				st,
+				&noopFileGetClient{},
+				&noopVStoreClient{},
+				e,
+				modelName,
+				testModels,
+				config.QuotasConfig{},
+				testr.New(t),
+			)
+			resp, err := srv.SearchVectorStore(fakeAuthInto(context.Background()), tc.req)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, vectorStoreSearchResultsObject, resp.Object)
+			assert.Equal(t, tc.req.Query, resp.SearchQuery)
+			var ids []string
+			for _, d := range resp.Data {
+				ids = append(ids, d.FileId)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+			assert.Equal(t, fileName, resp.Data[0].Filename)
+			assert.Equal(t, "hello", resp.Data[0].Content[0].Text)
+
+			var gotExpr string
+			if e.filter != nil {
+				gotExpr, err = e.filter.Expr()
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantExpr, gotExpr)
+
+			wantMode := tc.wantMode
+			if wantMode == "" {
+				wantMode = milvus.SearchModeDense
+			}
+			assert.Equal(t, wantMode, e.mode)
+			assert.Equal(t, tc.wantRerank, e.rerank)
+		})
+	}
+}
+
+func TestRemoveEnglishStopWords(t *testing.T) {
+	tcs := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "What is the error code E-1042?",
+			want:  "error code e-1042",
+		},
+		{
+			query: "  How do I configure   vector stores? ",
+			want:  "configure vector stores",
+		},
+		{
+			query: "What is it?",
+			want:  "it",
+		},
+		{
+			query: "what?",
+			want:  "what?",
+		},
+		{
+			query: `"to be or not to be"`,
+			want:  `"to be or not to be"`,
+		},
+		{
+			query: "Qu'est-ce que la vie à Paris ?",
+			want:  "Qu'est-ce que la vie à Paris ?",
+		},
+		{
+			query: "ベクトルストアとは何ですか?",
+			want:  "ベクトルストアとは何ですか?",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.query, func(t *testing.T) {
+			assert.Equal(t, tc.want, removeEnglishStopWords(tc.query))
 		})
 	}
 }
 
 type noopRetriever struct {
 	collectionName string
-	docs           map[string][]string
+	modelName      string
+	docs           map[string][]*milvus.SearchResult
 }
 
-func (c *noopRetriever) Search(ctx context.Context, collectionName, modelName, query string, numDocuments int) ([]string, error) {
+func (c *noopRetriever) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	modelName, query string,
+	numDocuments int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+	rerank bool,
+) ([]*milvus.SearchResult, error) {
 	if collectionName != c.collectionName {
 		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
+	if modelName != c.modelName {
+		return nil, fmt.Errorf("unexpected model %s", modelName)
+	}
 	return c.docs[query], nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
 	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -31,41 +32,42 @@ type fileGetClient interface {
 	GetFile(ctx context.Context, in *fv1.GetFileRequest, opts ...grpc.CallOption) (*fv1.File, error)
 }
 
-type fileInternalClient interface {
-	GetFilePath(ctx context.Context, in *fv1.GetFilePathRequest, opts ...grpc.CallOption) (*fv1.GetFilePathResponse, error)
-}
-
 type vstoreClient interface {
-	CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error)
+	CreateVectorStore(ctx context.Context, name string, dimensions int, index milvus.IndexConfig) (int64, error)
 	DeleteVectorStore(ctx context.Context, name string) error
-	ListVectorStores(ctx context.Context) ([]int64, error)
+	ListVectorStores(ctx context.Context) ([]string, error)
 }
 
 type embedder interface {
-	AddFile(ctx context.Context, collectionName, modelName, fileID, fileName, filePath string, chunkSizeTokens, chunkOverlapTokens int64) error
+	retriever
 	DeleteFile(ctx context.Context, collectionName, fileID string) error
 }
 
-// New creates a server.
+type modelRegistry interface {
+	Dimensions(ctx context.Context, model string) (int, error)
+}
+
+// New creates a server. The model is the default embedding model used for vector stores created without
+// specifying a model.
 func New(
 	store *store.S,
 	fileGetClient fileGetClient,
-	fileInternalClient fileInternalClient,
 	vstoreClient vstoreClient,
 	e embedder,
 	model string,
-	dimensions int,
+	models modelRegistry,
+	quotas config.QuotasConfig,
 	log logr.Logger,
 ) *S {
 	return &S{
-		store:              store,
-		fileGetClient:      fileGetClient,
-		fileInternalClient: fileInternalClient,
-		vstoreClient:       vstoreClient,
-		embedder:           e,
-		model:              model,
-		dimensions:         dimensions,
-		log:                log.WithName("grpc"),
+		store:         store,
+		fileGetClient: fileGetClient,
+		vstoreClient:  vstoreClient,
+		embedder:      e,
+		model:         model,
+		models:        models,
+		quotas:        quotas,
+		log:           log.WithName("grpc"),
 	}
 }
 
@@ -73,15 +75,15 @@ func New(
 type S struct {
 	v1.UnimplementedVectorStoreServiceServer
 
-	model      string
-	dimensions int
-	embedder   embedder
+	model    string
+	models   modelRegistry
+	embedder embedder
+	quotas   config.QuotasConfig
 
-	fileInternalClient fileInternalClient
-	fileGetClient      fileGetClient
-	vstoreClient       vstoreClient
-	store              *store.S
-	log                logr.Logger
+	fileGetClient fileGetClient
+	vstoreClient  vstoreClient
+	store         *store.S
+	log           logr.Logger
 
 	srv *grpc.Server
 }
@@ -0,0 +1,395 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/llmariner/common/pkg/id"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	vectorStoreFileBatchObject = "vector_store.files_batch"
+
+	maxFileBatchSize = 500
+)
+
+// CreateVectorStoreFileBatch adds multiple files to the vector store.
+func (s *S) CreateVectorStoreFileBatch(
+	ctx context.Context,
+	req *v1.CreateVectorStoreFileBatchRequest,
+) (*v1.VectorStoreFileBatch, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if len(req.FileIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file ids are required")
+	}
+	if len(req.FileIds) > maxFileBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "no more than %d files are allowed in a batch", maxFileBatchSize)
+	}
+	seen := map[string]bool{}
+	for _, fid := range req.FileIds {
+		if fid == "" {
+			return nil, status.Error(codes.InvalidArgument, "file id is required")
+		}
+		if seen[fid] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate file id %q", fid)
+		}
+		seen[fid] = true
+	}
+
+	cs, err := getChunkingStrategy(req.ChunkingStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+	if err := validateNotExpired(c); err != nil {
+		return nil, err
+	}
+
+	// Pass the Authorization to the context for downstream gRPC calls.
+	ctx = auth.CarryMetadata(ctx)
+
+	filenames := map[string]string{}
+	for _, fid := range req.FileIds {
+		f, err := s.validateFile(ctx, fid)
+		if err != nil {
+			return nil, err
+		}
+		filenames[fid] = f.Filename
+		if _, err := s.store.GetFileByFileID(req.VectorStoreId, fid); err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "file %q already exists in vector store %q", fid, req.VectorStoreId)
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Internal, "get file: %s", err)
+		}
+	}
+
+	batchID, err := id.GenerateID("vsfb_", 24)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate id: %s", err)
+	}
+	numFiles := int64(len(req.FileIds))
+	b := &store.FileBatch{
+		BatchID:              batchID,
+		VectorStoreID:        req.VectorStoreId,
+		Status:               store.FileBatchStatusInProgress,
+		FileCountsInProgress: numFiles,
+		FileCountsTotal:      numFiles,
+	}
+
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := s.validateFileQuotaInTransaction(tx, userInfo.ProjectID, req.VectorStoreId, numFiles); err != nil {
+			return err
+		}
+		if err := store.CreateFileBatchInTransaction(tx, b); err != nil {
+			return fmt.Errorf("create file batch: %s", err)
+		}
+		for _, fid := range req.FileIds {
+			f := &store.File{
+				FileID:                       fid,
+				VectorStoreID:                req.VectorStoreId,
+				Filename:                     filenames[fid],
+				BatchID:                      batchID,
+				Status:                       store.FileStatusInProgress,
+				ChunkingStrategyType:         cs.chunkingStrategyType,
+				MaxChunkSizeTokens:           cs.maxChunkSizeTokens,
+				ChunkOverlapTokens:           cs.chunkOverlapTokens,
+				SemanticBreakpointPercentile: cs.breakpointPercentile,
+				SemanticBufferSize:           cs.bufferSize,
+			}
+			if err := store.CreateFileInTransaction(tx, f); err != nil {
+				return fmt.Errorf("create file: %s", err)
+			}
+			if err := createFileAttributesInTransaction(tx, req.VectorStoreId, fid, req.Attributes); err != nil {
+				return err
+			}
+		}
+
+		d := store.FileCountsDelta{InProgress: numFiles, Total: numFiles}
+		if err := store.IncrementCollectionFileCountsInTransaction(tx, req.VectorStoreId, d, 0); err != nil {
+			return fmt.Errorf("increment file counts: %s", err)
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.Aborted, "concurrent update: %s", err)
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
+	}
+	if err := markActive(s.store, c); err != nil {
+		return nil, err
+	}
+	s.log.Info("Queued file batch for ingestion", "batch", batchID, "store", req.VectorStoreId, "numFiles", numFiles)
+
+	return toVectorStoreFileBatchProto(b), nil
+}
+
+// GetVectorStoreFileBatch gets a file batch of the vector store.
+func (s *S) GetVectorStoreFileBatch(
+	ctx context.Context,
+	req *v1.GetVectorStoreFileBatchRequest,
+) (*v1.VectorStoreFileBatch, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if req.BatchId == "" {
+		return nil, status.Error(codes.InvalidArgument, "batch id is required")
+	}
+
+	if err := s.validateVectorStore(req.VectorStoreId, userInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	b, err := s.getFileBatch(req.VectorStoreId, req.BatchId)
+	if err != nil {
+		return nil, err
+	}
+	return toVectorStoreFileBatchProto(b), nil
+}
+
+// CancelVectorStoreFileBatch cancels a file batch. Files in the batch that have not been processed yet are cancelled.
+func (s *S) CancelVectorStoreFileBatch(
+	ctx context.Context,
+	req *v1.CancelVectorStoreFileBatchRequest,
+) (*v1.VectorStoreFileBatch, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if req.BatchId == "" {
+		return nil, status.Error(codes.InvalidArgument, "batch id is required")
+	}
+
+	if err := s.validateVectorStore(req.VectorStoreId, userInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	b, err := s.getFileBatch(req.VectorStoreId, req.BatchId)
+	if err != nil {
+		return nil, err
+	}
+	if b.Status != store.FileBatchStatusInProgress {
+		return nil, status.Errorf(codes.FailedPrecondition, "file batch %q is %s", req.BatchId, b.Status)
+	}
+
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		b, err = store.GetFileBatchByBatchIDInTransaction(tx, req.VectorStoreId, req.BatchId)
+		if err != nil {
+			return fmt.Errorf("get file batch: %s", err)
+		}
+		// The batch might have been completed since it was read.
+		if b.Status != store.FileBatchStatusInProgress {
+			return status.Errorf(codes.FailedPrecondition, "file batch %q is %s", req.BatchId, b.Status)
+		}
+		n, err := store.CancelInProgressFilesInBatchInTransaction(tx, req.VectorStoreId, req.BatchId)
+		if err != nil {
+			return fmt.Errorf("cancel files: %s", err)
+		}
+
+		b.Status = store.FileBatchStatusCancelled
+		if err := store.UpdateFileBatchInTransaction(tx, b); err != nil {
+			return err
+		}
+
+		d := store.FileCountsDelta{InProgress: -n, Cancelled: n}
+		if err := store.IncrementFileBatchFileCountsInTransaction(tx, req.VectorStoreId, req.BatchId, d); err != nil {
+			return fmt.Errorf("increment file batch counts: %s", err)
+		}
+		if err := store.IncrementCollectionFileCountsInTransaction(tx, req.VectorStoreId, d, 0); err != nil {
+			return fmt.Errorf("increment file counts: %s", err)
+		}
+
+		b, err = store.GetFileBatchByBatchIDInTransaction(tx, req.VectorStoreId, req.BatchId)
+		if err != nil {
+			return fmt.Errorf("get file batch: %s", err)
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.Aborted, "concurrent update: %s", err)
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
+	}
+	s.log.Info("Cancelled file batch", "batch", req.BatchId, "store", req.VectorStoreId)
+
+	return toVectorStoreFileBatchProto(b), nil
+}
+
+// ListFilesInVectorStoreBatch lists files in a file batch.
+func (s *S) ListFilesInVectorStoreBatch(
+	ctx context.Context,
+	req *v1.ListFilesInVectorStoreBatchRequest,
+) (*v1.ListVectorStoreFilesResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if req.BatchId == "" {
+		return nil, status.Error(codes.InvalidArgument, "batch id is required")
+	}
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be non-negative")
+	}
+
+	filter := store.FileStatus(req.Filter)
+	switch filter {
+	case "", store.FileStatusInProgress, store.FileStatusCompleted, store.FileStatusFailed, store.FileStatusCancelled:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "filter must be one of 'in_progress', 'completed', 'failed' or 'cancelled'")
+	}
+
+	if err := s.validateVectorStore(req.VectorStoreId, userInfo.ProjectID); err != nil {
+		return nil, err
+	}
+	if _, err := s.getFileBatch(req.VectorStoreId, req.BatchId); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	order := strings.ToLower(req.Order)
+	if order != "" && order != "asc" && order != "desc" {
+		return nil, status.Errorf(codes.InvalidArgument, "order must be one of 'asc' or 'desc'")
+	}
+
+	if req.After != "" && req.Before != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "only one of after and before can be set")
+	}
+	// Files before the cursor are listed as files after the cursor in the reverse order. hasMore is then true if
+	// there are more files before the returned files.
+	cursor, cursorName := req.After, "after"
+	if req.Before != "" {
+		cursor, cursorName = req.Before, "before"
+		if order == "asc" {
+			order = "desc"
+		} else {
+			order = "asc"
+		}
+	}
+
+	var afterCreatedAt time.Time
+	var afterID uint
+	if cursor != "" {
+		f, err := s.store.GetFileByFileID(req.VectorStoreId, cursor)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "invalid value of %s: %q", cursorName, cursor)
+			}
+			return nil, status.Errorf(codes.Internal, "get file: %s", err)
+		}
+		if f.BatchID != req.BatchId {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value of %s: file %q is not in batch %q", cursorName, cursor, req.BatchId)
+		}
+		afterCreatedAt = f.CreatedAt
+		afterID = f.ID
+	}
+
+	fs, hasMore, err := s.store.ListFilesInBatchWithPagination(req.VectorStoreId, req.BatchId, filter, afterCreatedAt, afterID, order, int(limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list files in batch with pagination: %s", err)
+	}
+	if req.Before != "" {
+		slices.Reverse(fs)
+	}
+
+	var protos []*v1.VectorStoreFile
+	for _, f := range fs {
+		attrs, err := s.getFileAttributes(f)
+		if err != nil {
+			return nil, err
+		}
+		protos = append(protos, toVectorStoreFileProto(f, attrs))
+	}
+	first := ""
+	last := ""
+	if len(protos) > 0 {
+		first = protos[0].Id
+		last = protos[len(protos)-1].Id
+	}
+	return &v1.ListVectorStoreFilesResponse{
+		Object:  vectorStoreFileObject,
+		Data:    protos,
+		FirstId: first,
+		LastId:  last,
+		HasMore: hasMore,
+	}, nil
+}
+
+func (s *S) getFileBatch(vectorStoreID, batchID string) (*store.FileBatch, error) {
+	b, err := s.store.GetFileBatchByBatchID(vectorStoreID, batchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "file batch %q not found in vector store %q", batchID, vectorStoreID)
+		}
+		return nil, status.Errorf(codes.Internal, "get file batch: %s", err)
+	}
+	return b, nil
+}
+
+func toVectorStoreFileBatchProto(b *store.FileBatch) *v1.VectorStoreFileBatch {
+	return &v1.VectorStoreFileBatch{
+		Id:            b.BatchID,
+		Object:        vectorStoreFileBatchObject,
+		CreatedAt:     b.CreatedAt.Unix(),
+		VectorStoreId: b.VectorStoreID,
+		Status:        string(b.Status),
+		FileCounts: &v1.VectorStoreFileBatch_FileCounts{
+			InProgress: b.FileCountsInProgress,
+			Completed:  b.FileCountsCompleted,
+			Failed:     b.FileCountsFailed,
+			Cancelled:  b.FileCountsCancelled,
+			Total:      b.FileCountsTotal,
+		},
+	}
+}
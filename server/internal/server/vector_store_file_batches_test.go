@@ -0,0 +1,324 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateVectorStoreFileBatch(t *testing.T) {
+	tcs := []struct {
+		name     string
+		req      *v1.CreateVectorStoreFileBatchRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			req: &v1.CreateVectorStoreFileBatchRequest{
+				VectorStoreId: vectorStoreID,
+				FileIds:       []string{"file0", "file1"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "no files",
+			req: &v1.CreateVectorStoreFileBatchRequest{
+				VectorStoreId: vectorStoreID,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "duplicate files",
+			req: &v1.CreateVectorStoreFileBatchRequest{
+				VectorStoreId: vectorStoreID,
+				FileIds:       []string{"file0", "file0"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown file",
+			req: &v1.CreateVectorStoreFileBatchRequest{
+				VectorStoreId: vectorStoreID,
+				FileIds:       []string{"file0", "unknown"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown vector store",
+			req: &v1.CreateVectorStoreFileBatchRequest{
+				VectorStoreId: "unknown",
+				FileIds:       []string{"file0"},
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			srv := newTestFileBatchServer(t, st)
+			ctx := fakeAuthInto(context.Background())
+			resp, err := srv.CreateVectorStoreFileBatch(ctx, tc.req)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+
+				// No file should be added.
+				vs, err := srv.GetVectorStore(ctx, &v1.GetVectorStoreRequest{Id: vectorStoreID})
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), vs.FileCounts.Total)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, vectorStoreFileBatchObject, resp.Object)
+			assert.Equal(t, vectorStoreID, resp.VectorStoreId)
+			assert.Equal(t, string(store.FileBatchStatusInProgress), resp.Status)
+			assert.Equal(t, int64(2), resp.FileCounts.InProgress)
+			assert.Equal(t, int64(2), resp.FileCounts.Total)
+
+			got, err := srv.GetVectorStoreFileBatch(ctx, &v1.GetVectorStoreFileBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       resp.Id,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, resp.Id, got.Id)
+
+			vs, err := srv.GetVectorStore(ctx, &v1.GetVectorStoreRequest{Id: vectorStoreID})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), vs.FileCounts.InProgress)
+			assert.Equal(t, int64(2), vs.FileCounts.Total)
+
+			// The files are already in the vector store.
+			_, err = srv.CreateVectorStoreFileBatch(ctx, tc.req)
+			assert.Error(t, err)
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		})
+	}
+}
+
+func TestCancelVectorStoreFileBatch(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := newTestFileBatchServer(t, st)
+	ctx := fakeAuthInto(context.Background())
+	b, err := srv.CreateVectorStoreFileBatch(ctx, &v1.CreateVectorStoreFileBatchRequest{
+		VectorStoreId: vectorStoreID,
+		FileIds:       []string{"file0", "file1"},
+	})
+	assert.NoError(t, err)
+
+	// Simulate the completion of the first file.
+	f, err := st.GetFileByFileID(vectorStoreID, "file0")
+	assert.NoError(t, err)
+	f.Status = store.FileStatusCompleted
+	err = st.UpdateFile(f)
+	assert.NoError(t, err)
+
+	got, err := srv.CancelVectorStoreFileBatch(ctx, &v1.CancelVectorStoreFileBatchRequest{
+		VectorStoreId: vectorStoreID,
+		BatchId:       b.Id,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, string(store.FileBatchStatusCancelled), got.Status)
+	assert.Equal(t, int64(1), got.FileCounts.InProgress)
+	assert.Equal(t, int64(1), got.FileCounts.Cancelled)
+
+	f, err = st.GetFileByFileID(vectorStoreID, "file1")
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusCancelled, f.Status)
+
+	vs, err := srv.GetVectorStore(ctx, &v1.GetVectorStoreRequest{Id: vectorStoreID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), vs.FileCounts.InProgress)
+	assert.Equal(t, int64(1), vs.FileCounts.Cancelled)
+	assert.Equal(t, int64(2), vs.FileCounts.Total)
+
+	// The batch has already been cancelled.
+	_, err = srv.CancelVectorStoreFileBatch(ctx, &v1.CancelVectorStoreFileBatchRequest{
+		VectorStoreId: vectorStoreID,
+		BatchId:       b.Id,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.CancelVectorStoreFileBatch(ctx, &v1.CancelVectorStoreFileBatchRequest{
+		VectorStoreId: vectorStoreID,
+		BatchId:       "unknown",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListFilesInVectorStoreBatch(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := newTestFileBatchServer(t, st)
+	ctx := fakeAuthInto(context.Background())
+	b, err := srv.CreateVectorStoreFileBatch(ctx, &v1.CreateVectorStoreFileBatchRequest{
+		VectorStoreId: vectorStoreID,
+		FileIds:       []string{"file0", "file1"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateVectorStoreFile(ctx, &v1.CreateVectorStoreFileRequest{
+		VectorStoreId: vectorStoreID,
+		FileId:        "file2",
+	})
+	assert.NoError(t, err)
+
+	f, err := st.GetFileByFileID(vectorStoreID, "file0")
+	assert.NoError(t, err)
+	f.Status = store.FileStatusFailed
+	err = st.UpdateFile(f)
+	assert.NoError(t, err)
+
+	tcs := []struct {
+		name    string
+		req     *v1.ListFilesInVectorStoreBatchRequest
+		wantIDs []string
+		wantErr bool
+	}{
+		{
+			name: "all",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Order:         "asc",
+			},
+			wantIDs: []string{"file0", "file1"},
+		},
+		{
+			name: "after",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Order:         "asc",
+				After:         "file0",
+			},
+			wantIDs: []string{"file1"},
+		},
+		{
+			name: "before",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Order:         "asc",
+				Before:        "file1",
+			},
+			wantIDs: []string{"file0"},
+		},
+		{
+			name: "before in descending order",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Before:        "file0",
+			},
+			wantIDs: []string{"file1"},
+		},
+		{
+			name: "cursor not in the batch",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				After:         "file2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "after and before",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				After:         "file0",
+				Before:        "file1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "filter",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Filter:        string(store.FileStatusFailed),
+			},
+			wantIDs: []string{"file0"},
+		},
+		{
+			name: "invalid filter",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       b.Id,
+				Filter:        "unknown",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown batch",
+			req: &v1.ListFilesInVectorStoreBatchRequest{
+				VectorStoreId: vectorStoreID,
+				BatchId:       "unknown",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := srv.ListFilesInVectorStoreBatch(ctx, tc.req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var ids []string
+			for _, f := range resp.Data {
+				ids = append(ids, f.Id)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+		})
+	}
+}
+
+func newTestFileBatchServer(t *testing.T, st *store.S) *S {
+	srv := New(
+		st,
+		&noopFileGetClient{
+			ids: map[string]string{
+				"file0": "file0.txt",
+				"file1": "file1.txt",
+				"file2": "file2.txt",
+			},
+		},
+		&noopVStoreClient{
+			vs: map[string]int64{
+				vectorStoreID: 1,
+			},
+		},
+		&noopEmbedder{
+			collectionName: vectorStoreID,
+		},
+		modelName,
+		testModels,
+		config.QuotasConfig{},
+		testr.New(t),
+	)
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:  collectionID,
+		VectorStoreID: vectorStoreID,
+		Name:          collectionName,
+		Status:        store.CollectionStatusCompleted,
+		ProjectID:     "default",
+	})
+	assert.NoError(t, err)
+	return srv
+}
@@ -10,6 +10,7 @@ import (
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,16 +20,29 @@ import (
 const (
 	vectorStoreFileObject = "vector_store_file"
 
+	maxAttributeEntries     = 16
+	maxAttributeKeyLength   = 64
+	maxAttributeValueLength = 512
+
 	minMaxChunkSizeTokens     = int64(100)
 	maxMaxChunkSizeTokens     = int64(4096)
 	defaultMaxChunkSizeTokens = int64(800)
 	defaultChunkOverlapTokens = int64(400)
+
+	defaultBreakpointPercentile = 95
+	minBufferSize               = 1
+	maxBufferSize               = 5
+	defaultBufferSize           = 1
 )
 
 type chunkingStrategy struct {
 	maxChunkSizeTokens   int64
 	chunkOverlapTokens   int64
 	chunkingStrategyType store.ChunkingStrategyType
+
+	// breakpointPercentile and bufferSize are set for the semantic chunking strategy.
+	breakpointPercentile int
+	bufferSize           int
 }
 
 // CreateVectorStoreFile adds a new file to the vector store.
@@ -53,6 +67,10 @@ func (s *S) CreateVectorStoreFile(
 		return nil, err
 	}
 
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+
 	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -60,98 +78,170 @@ func (s *S) CreateVectorStoreFile(
 		}
 		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
 	}
+	if err := validateNotExpired(c); err != nil {
+		return nil, err
+	}
 
 	file, err := s.validateFile(auth.CarryMetadata(ctx), req.FileId)
 	if err != nil {
 		return nil, err
 	}
-	f, err := s.createVectorStoreFile(ctx, c, file, cs)
+	f, err := s.createVectorStoreFile(c, file, cs, req.Attributes)
 	if err != nil {
 		return nil, err
 	}
-
-	c, err = s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, c.VectorStoreID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
-	}
-	c.FileCountsCompleted++
-	c.FileCountsTotal++
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
+	if err := markActive(s.store, c); err != nil {
+		return nil, err
 	}
-	return toVectorStoreFileProto(f), nil
+	return toVectorStoreFileProto(f, req.Attributes), nil
 }
 
-func (s *S) createVectorStoreFile(ctx context.Context, c *store.Collection, f *fv1.File, cs *chunkingStrategy) (*store.File, error) {
+// createVectorStoreFile creates a file in the vector store and updates the file counts of the vector store. The file is
+// embedded asynchronously by an ingestion worker.
+func (s *S) createVectorStoreFile(c *store.Collection, f *fv1.File, cs *chunkingStrategy, attrs map[string]string) (*store.File, error) {
 	if _, err := s.store.GetFileByFileID(c.VectorStoreID, f.Id); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "file %q already exists in vector store %q", f.Id, c.VectorStoreID)
 	}
 
-	resp, err := s.fileInternalClient.GetFilePath(ctx, &fv1.GetFilePathRequest{Id: f.Id})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get file path: %s", err)
-	}
-
-	log := s.log.WithValues("file", f.Id, "store", c.VectorStoreID)
-	log.Info("Adding file to vector store")
-	if err := s.embedder.AddFile(
-		ctx,
-		c.VectorStoreID,
-		c.EmbeddingModel,
-		f.Id,
-		f.Filename,
-		resp.Path,
-		cs.maxChunkSizeTokens,
-		cs.chunkOverlapTokens,
-	); err != nil {
-		return nil, status.Errorf(codes.Internal, "add file: %s", err)
-	}
 	file := &store.File{
-		FileID:               f.Id,
-		VectorStoreID:        c.VectorStoreID,
-		UsageBytes:           0,
-		Status:               store.FileStatusCompleted,
-		ChunkingStrategyType: cs.chunkingStrategyType,
-		MaxChunkSizeTokens:   cs.maxChunkSizeTokens,
-		ChunkOverlapTokens:   cs.chunkOverlapTokens,
-	}
-	if err := s.store.CreateFile(file); err != nil {
-		return nil, status.Errorf(codes.Internal, "create file: %s", err)
-	}
-	log.Info("Added file to vector store")
+		FileID:                       f.Id,
+		VectorStoreID:                c.VectorStoreID,
+		Filename:                     f.Filename,
+		Status:                       store.FileStatusInProgress,
+		ChunkingStrategyType:         cs.chunkingStrategyType,
+		MaxChunkSizeTokens:           cs.maxChunkSizeTokens,
+		ChunkOverlapTokens:           cs.chunkOverlapTokens,
+		SemanticBreakpointPercentile: cs.breakpointPercentile,
+		SemanticBufferSize:           cs.bufferSize,
+	}
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := s.validateFileQuotaInTransaction(tx, c.ProjectID, c.VectorStoreID, 1); err != nil {
+			return err
+		}
+		if err := store.CreateFileInTransaction(tx, file); err != nil {
+			return fmt.Errorf("create file: %s", err)
+		}
+		if err := createFileAttributesInTransaction(tx, c.VectorStoreID, f.Id, attrs); err != nil {
+			return err
+		}
+		d := store.FileCountsDelta{InProgress: 1, Total: 1}
+		if err := store.IncrementCollectionFileCountsInTransaction(tx, c.VectorStoreID, d, 0); err != nil {
+			return fmt.Errorf("increment file counts: %s", err)
+		}
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
+	}
+	s.log.Info("Queued file for ingestion", "file", f.Id, "store", c.VectorStoreID)
 	return file, nil
 }
 
+func createFileAttributesInTransaction(tx *gorm.DB, vectorStoreID, fileID string, attrs map[string]string) error {
+	for k, v := range attrs {
+		if err := store.CreateFileAttributeInTransaction(tx, &store.FileAttribute{
+			VectorStoreID: vectorStoreID,
+			FileID:        fileID,
+			Key:           k,
+			Value:         v,
+		}); err != nil {
+			return fmt.Errorf("create file attribute: %s", err)
+		}
+	}
+	return nil
+}
+
+func validateAttributes(attrs map[string]string) error {
+	if len(attrs) > maxAttributeEntries {
+		return status.Errorf(codes.InvalidArgument, "no more than %d attributes are allowed", maxAttributeEntries)
+	}
+	for k, v := range attrs {
+		if len(k) > maxAttributeKeyLength {
+			return status.Errorf(codes.InvalidArgument, "attribute key %q is too long, max allowed is %d", k, maxAttributeKeyLength)
+		}
+		if err := milvus.ValidateAttributeKey(k); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		if len(v) > maxAttributeValueLength {
+			return status.Errorf(codes.InvalidArgument, "attribute value for key %q is too long, max allowed is %d", k, maxAttributeValueLength)
+		}
+	}
+	return nil
+}
+
+// getChunkingStrategy returns the chunking strategy of the request. The static strategy with the default sizes is used
+// if not specified. The sizes of the auto strategy are chosen when the file is processed.
 func getChunkingStrategy(cs *v1.ChunkingStrategy) (*chunkingStrategy, error) {
 	ret := &chunkingStrategy{
 		maxChunkSizeTokens:   defaultMaxChunkSizeTokens,
 		chunkOverlapTokens:   defaultChunkOverlapTokens,
 		chunkingStrategyType: store.ChunkingStrategyTypeStatic,
 	}
-	if cs != nil {
-		if err := validateChunkingStrategy(cs); err != nil {
-			return nil, err
+	if cs == nil {
+		return ret, nil
+	}
+	if err := validateChunkingStrategy(cs); err != nil {
+		return nil, err
+	}
+	switch store.ChunkingStrategyType(cs.Type) {
+	case store.ChunkingStrategyTypeAuto:
+		return &chunkingStrategy{
+			chunkingStrategyType: store.ChunkingStrategyTypeAuto,
+		}, nil
+	case store.ChunkingStrategyTypeSemantic:
+		ret = &chunkingStrategy{
+			maxChunkSizeTokens:   defaultMaxChunkSizeTokens,
+			chunkingStrategyType: store.ChunkingStrategyTypeSemantic,
+			breakpointPercentile: defaultBreakpointPercentile,
+			bufferSize:           defaultBufferSize,
 		}
-		if cs.Static != nil {
-			ret.maxChunkSizeTokens = cs.Static.MaxChunkSizeTokens
-			ret.chunkOverlapTokens = cs.Static.ChunkOverlapTokens
+		if sem := cs.Semantic; sem != nil {
+			if sem.MaxChunkSizeTokens != 0 {
+				ret.maxChunkSizeTokens = sem.MaxChunkSizeTokens
+			}
+			if sem.BreakpointPercentile != 0 {
+				ret.breakpointPercentile = int(sem.BreakpointPercentile)
+			}
+			if sem.BufferSize != 0 {
+				ret.bufferSize = int(sem.BufferSize)
+			}
 		}
+		return ret, nil
+	}
+	if cs.Static != nil {
+		ret.maxChunkSizeTokens = cs.Static.MaxChunkSizeTokens
+		ret.chunkOverlapTokens = cs.Static.ChunkOverlapTokens
 	}
 	return ret, nil
 }
 
 func validateChunkingStrategy(cs *v1.ChunkingStrategy) error {
-	if cs.Type != string(store.ChunkingStrategyTypeAuto) && cs.Type != string(store.ChunkingStrategyTypeStatic) {
-		return status.Errorf(codes.InvalidArgument, "chunking strategy type must be either auto or static")
+	switch store.ChunkingStrategyType(cs.Type) {
+	case store.ChunkingStrategyTypeAuto, store.ChunkingStrategyTypeStatic, store.ChunkingStrategyTypeSemantic:
+	default:
+		return status.Errorf(codes.InvalidArgument, "chunking strategy type must be one of auto, static, or semantic")
+	}
+	if cs.Auto != nil {
+		return status.Errorf(codes.InvalidArgument, "auto chunking parameters cannot be specified")
+	}
+	if cs.Semantic != nil {
+		if cs.Type != string(store.ChunkingStrategyTypeSemantic) {
+			return status.Errorf(codes.InvalidArgument, "semantic chunking parameters cannot be specified for the %s chunking strategy", cs.Type)
+		}
+		if err := validateSemanticChunkingStrategy(cs.Semantic); err != nil {
+			return err
+		}
 	}
 	if cs.Static == nil {
 		return nil
 	}
-	if cs.Static.MaxChunkSizeTokens < minMaxChunkSizeTokens {
-		return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no less than %d", minMaxChunkSizeTokens)
+	if cs.Type != string(store.ChunkingStrategyTypeStatic) {
+		return status.Errorf(codes.InvalidArgument, "static chunking parameters cannot be specified for the %s chunking strategy", cs.Type)
 	}
-	if cs.Static.MaxChunkSizeTokens > maxMaxChunkSizeTokens {
-		return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no more than %d", maxMaxChunkSizeTokens)
+	if err := validateMaxChunkSizeTokens(cs.Static.MaxChunkSizeTokens); err != nil {
+		return err
 	}
 	if cs.Static.ChunkOverlapTokens <= 0 {
 		return status.Errorf(codes.InvalidArgument, "chunk overlap tokens must be greater than 0")
@@ -162,6 +252,30 @@ func validateChunkingStrategy(cs *v1.ChunkingStrategy) error {
 	return nil
 }
 
+func validateSemanticChunkingStrategy(sem *v1.ChunkingStrategy_Semantic) error {
+	// Zero values are replaced with defaults.
+	if p := sem.BreakpointPercentile; p != 0 && (p < 1 || p > 99) {
+		return status.Errorf(codes.InvalidArgument, "breakpoint percentile must be between 1 and 99")
+	}
+	if b := sem.BufferSize; b != 0 && (b < minBufferSize || b > maxBufferSize) {
+		return status.Errorf(codes.InvalidArgument, "buffer size must be between %d and %d", minBufferSize, maxBufferSize)
+	}
+	if sem.MaxChunkSizeTokens != 0 {
+		return validateMaxChunkSizeTokens(sem.MaxChunkSizeTokens)
+	}
+	return nil
+}
+
+func validateMaxChunkSizeTokens(n int64) error {
+	if n < minMaxChunkSizeTokens {
+		return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no less than %d", minMaxChunkSizeTokens)
+	}
+	if n > maxMaxChunkSizeTokens {
+		return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no more than %d", maxMaxChunkSizeTokens)
+	}
+	return nil
+}
+
 // GetVectorStoreFile gets a file from the vector store.
 func (s *S) GetVectorStoreFile(
 	ctx context.Context,
@@ -190,7 +304,11 @@ func (s *S) GetVectorStoreFile(
 		}
 		return nil, status.Errorf(codes.Internal, "get file: %s", err)
 	}
-	return toVectorStoreFileProto(f), nil
+	attrs, err := s.getFileAttributes(f)
+	if err != nil {
+		return nil, err
+	}
+	return toVectorStoreFileProto(f, attrs), nil
 }
 
 // ListVectorStoreFiles lists files in the vector store.
@@ -248,7 +366,11 @@ func (s *S) ListVectorStoreFiles(
 
 	var protos []*v1.VectorStoreFile
 	for _, f := range fs {
-		protos = append(protos, toVectorStoreFileProto(f))
+		attrs, err := s.getFileAttributes(f)
+		if err != nil {
+			return nil, err
+		}
+		protos = append(protos, toVectorStoreFileProto(f, attrs))
 	}
 	first := ""
 	last := ""
@@ -282,31 +404,63 @@ func (s *S) DeleteVectorStoreFile(
 		return nil, status.Error(codes.InvalidArgument, "file id is required")
 	}
 
-	if err := s.validateVectorStore(req.VectorStoreId, userInfo.ProjectID); err != nil {
-		return nil, err
-	}
-
-	// TODO(guangrui): Gracefully handle the deletion error.
-	if err := s.embedder.DeleteFile(ctx, req.VectorStoreId, req.FileId); err != nil {
-		// milvus does not return error if the file does not exist.
-		return nil, status.Errorf(codes.Internal, "embedder delete file: %s", err)
+	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "collection %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
 	}
 
-	if err := s.store.DeleteFile(req.VectorStoreId, req.FileId); err != nil {
+	f, err := s.store.GetFileByFileID(req.VectorStoreId, req.FileId)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "file %q not found in vector store %q", req.FileId, req.VectorStoreId)
 		}
-		return nil, status.Errorf(codes.Internal, "delete file: %s", err)
+		return nil, status.Errorf(codes.Internal, "get file: %s", err)
 	}
 
-	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	// Update the file counts and the usage in the same transaction as the deletion of the file so that they are
+	// consistent with the files. The file is deleted only if it has not been updated since it was read. The chunks
+	// are deleted after the transaction is committed so that they are kept if the file is not deleted.
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := store.DeleteFileWithVersionInTransaction(tx, f); err != nil {
+			return err
+		}
+		if err := store.DeleteAllFileAttributesByFileIDInTransaction(tx, req.VectorStoreId, req.FileId); err != nil {
+			return fmt.Errorf("delete file attributes: %s", err)
+		}
+
+		var d store.FileCountsDelta
+		d.Add(f.Status, -1)
+		d.Total = -1
+		if err := store.IncrementCollectionFileCountsInTransaction(tx, req.VectorStoreId, d, -f.UsageBytes); err != nil {
+			return fmt.Errorf("increment file counts: %s", err)
+		}
+		if f.BatchID == "" {
+			return nil
+		}
+		if err := store.IncrementFileBatchFileCountsInTransaction(tx, req.VectorStoreId, f.BatchID, d); err != nil {
+			return fmt.Errorf("increment file batch counts: %s", err)
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.Aborted, "concurrent update: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
+	}
+
+	// Chunks left behind by a failed deletion are not returned with file names in search results. A worker that is
+	// embedding the file deletes its chunks once it finds that the file has been deleted.
+	if err := s.embedder.DeleteFile(ctx, req.VectorStoreId, req.FileId); err != nil {
+		s.log.Error(err, "Failed to delete the chunks of the file", "file", req.FileId, "store", req.VectorStoreId)
 	}
-	c.FileCountsCompleted--
-	c.FileCountsTotal--
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
+
+	if validateNotExpired(c) == nil {
+		if err := markActive(s.store, c); err != nil {
+			return nil, err
+		}
 	}
 
 	return &v1.DeleteVectorStoreFileResponse{
@@ -316,7 +470,19 @@ func (s *S) DeleteVectorStoreFile(
 	}, nil
 }
 
-func toVectorStoreFileProto(f *store.File) *v1.VectorStoreFile {
+func (s *S) getFileAttributes(f *store.File) (map[string]string, error) {
+	fas, err := s.store.ListFileAttributes(f.VectorStoreID, f.FileID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list file attributes: %s", err)
+	}
+	attrs := map[string]string{}
+	for _, fa := range fas {
+		attrs[fa.Key] = fa.Value
+	}
+	return attrs, nil
+}
+
+func toVectorStoreFileProto(f *store.File, attrs map[string]string) *v1.VectorStoreFile {
 	proto := &v1.VectorStoreFile{
 		Id:            f.FileID,
 		Object:        vectorStoreFileObject,
@@ -327,6 +493,7 @@ func toVectorStoreFileProto(f *store.File) *v1.VectorStoreFile {
 		ChunkingStrategy: &v1.ChunkingStrategy{
 			Type: string(f.ChunkingStrategyType),
 		},
+		Attributes: attrs,
 	}
 	if f.LastErrorCode != store.LastErrorCodeNone {
 		proto.LastError = &v1.VectorStoreFile_Error{
@@ -334,11 +501,26 @@ func toVectorStoreFileProto(f *store.File) *v1.VectorStoreFile {
 			Message: f.LastErrorMessage,
 		}
 	}
-	if f.ChunkingStrategyType == store.ChunkingStrategyTypeStatic {
+	switch f.ChunkingStrategyType {
+	case store.ChunkingStrategyTypeStatic:
 		proto.ChunkingStrategy.Static = &v1.ChunkingStrategy_Static{
 			MaxChunkSizeTokens: f.MaxChunkSizeTokens,
 			ChunkOverlapTokens: f.ChunkOverlapTokens,
 		}
+	case store.ChunkingStrategyTypeSemantic:
+		proto.ChunkingStrategy.Semantic = &v1.ChunkingStrategy_Semantic{
+			BreakpointPercentile: int32(f.SemanticBreakpointPercentile),
+			BufferSize:           int32(f.SemanticBufferSize),
+			MaxChunkSizeTokens:   f.MaxChunkSizeTokens,
+		}
+	case store.ChunkingStrategyTypeAuto:
+		if f.ChunkingMethod != "" {
+			proto.ChunkingStrategy.Auto = &v1.ChunkingStrategy_Auto{
+				Method:             f.ChunkingMethod,
+				MaxChunkSizeTokens: f.MaxChunkSizeTokens,
+				ChunkOverlapTokens: f.ChunkOverlapTokens,
+			}
+		}
 	}
 	return proto
 }
@@ -2,14 +2,17 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 const (
@@ -20,9 +23,10 @@ const (
 
 func TestCreateVectorStoreFile(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.CreateVectorStoreFileRequest
-		wantErr bool
+		name         string
+		req          *v1.CreateVectorStoreFileRequest
+		wantChunking store.ChunkingStrategyType
+		wantErr      bool
 	}{
 		{
 			name: "success",
@@ -30,7 +34,8 @@ func TestCreateVectorStoreFile(t *testing.T) {
 				FileId:        fileID,
 				VectorStoreId: vectorStoreID,
 			},
-			wantErr: false,
+			wantChunking: store.ChunkingStrategyTypeStatic,
+			wantErr:      false,
 		},
 		{
 			name: "success with files",
@@ -41,7 +46,76 @@ func TestCreateVectorStoreFile(t *testing.T) {
 					Type: string(store.ChunkingStrategyTypeAuto),
 				},
 			},
-			wantErr: false,
+			wantChunking: store.ChunkingStrategyTypeAuto,
+			wantErr:      false,
+		},
+		{
+			name: "auto chunking with static parameters",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeAuto),
+					Static: &v1.ChunkingStrategy_Static{
+						MaxChunkSizeTokens: 800,
+						ChunkOverlapTokens: 400,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "semantic chunking",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeSemantic),
+					Semantic: &v1.ChunkingStrategy_Semantic{
+						BreakpointPercentile: 90,
+					},
+				},
+			},
+			wantChunking: store.ChunkingStrategyTypeSemantic,
+			wantErr:      false,
+		},
+		{
+			name: "semantic chunking with invalid percentile",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeSemantic),
+					Semantic: &v1.ChunkingStrategy_Semantic{
+						BreakpointPercentile: 100,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success with attributes",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				Attributes: map[string]string{
+					"lang": "en",
+					"year": "2024",
+				},
+			},
+			wantChunking: store.ChunkingStrategyTypeStatic,
+			wantErr:      false,
+		},
+		{
+			name: "invalid attribute key",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				Attributes: map[string]string{
+					"a b": "c",
+				},
+			},
+			wantErr: true,
 		},
 		{
 			name: "invalid fileID",
@@ -73,11 +147,6 @@ func TestCreateVectorStoreFile(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: "test.txt",
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{
 						vectorStoreID: 1,
@@ -87,7 +156,8 @@ func TestCreateVectorStoreFile(t *testing.T) {
 					collectionName: vectorStoreID,
 				},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -108,7 +178,23 @@ func TestCreateVectorStoreFile(t *testing.T) {
 			assert.Equal(t, fileID, resp.Id)
 			assert.Equal(t, vectorStoreID, resp.VectorStoreId)
 			assert.Equal(t, vectorStoreFileObject, resp.Object)
-			assert.Equal(t, string(store.ChunkingStrategyTypeStatic), resp.ChunkingStrategy.Type)
+			assert.Equal(t, string(store.FileStatusInProgress), resp.Status)
+			assert.Equal(t, string(tc.wantChunking), resp.ChunkingStrategy.Type)
+
+			vs, err := srv.GetVectorStore(fakeAuthInto(context.Background()), &v1.GetVectorStoreRequest{Id: vectorStoreID})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), vs.FileCounts.InProgress)
+			assert.Equal(t, int64(1), vs.FileCounts.Total)
+
+			got, err := srv.GetVectorStoreFile(fakeAuthInto(context.Background()), &v1.GetVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, got.Attributes, len(tc.req.Attributes))
+			for k, v := range tc.req.Attributes {
+				assert.Equal(t, v, got.Attributes[k])
+			}
 		})
 	}
 }
@@ -124,11 +210,6 @@ func TestCreateVectorStoreFile_AlreadyExists(t *testing.T) {
 				fileID: fileName,
 			},
 		},
-		&noopFileInternalClient{
-			ids: map[string]string{
-				fileID: "test.txt",
-			},
-		},
 		&noopVStoreClient{
 			vs: map[string]int64{
 				vectorStoreID: 1,
@@ -138,7 +219,8 @@ func TestCreateVectorStoreFile_AlreadyExists(t *testing.T) {
 			collectionName: vectorStoreID,
 		},
 		modelName,
-		dimensions,
+		testModels,
+		config.QuotasConfig{},
 		testr.New(t),
 	)
 	err := st.CreateCollection(&store.Collection{
@@ -163,6 +245,56 @@ func TestCreateVectorStoreFile_AlreadyExists(t *testing.T) {
 	assert.Equal(t, codes.AlreadyExists, status.Code(err))
 }
 
+func TestCreateVectorStoreFile_Expired(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(
+		st,
+		&noopFileGetClient{
+			ids: map[string]string{
+				fileID: fileName,
+			},
+		},
+		&noopVStoreClient{
+			vs: map[string]int64{
+				vectorStoreID: 1,
+			},
+		},
+		&noopEmbedder{
+			collectionName: vectorStoreID,
+		},
+		modelName,
+		testModels,
+		config.QuotasConfig{},
+		testr.New(t),
+	)
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:     collectionID,
+		VectorStoreID:    vectorStoreID,
+		Name:             collectionName,
+		Status:           store.CollectionStatusExpired,
+		ProjectID:        "default",
+		ExpiresAfterDays: 7,
+	})
+	assert.NoError(t, err)
+
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.CreateVectorStoreFile(ctx, &v1.CreateVectorStoreFileRequest{
+		FileId:        fileID,
+		VectorStoreId: vectorStoreID,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.CreateVectorStoreFileBatch(ctx, &v1.CreateVectorStoreFileBatchRequest{
+		FileIds:       []string{fileID},
+		VectorStoreId: vectorStoreID,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestListVectorStoreFiles(t *testing.T) {
 	const (
 		fileID         = "file0"
@@ -239,13 +371,6 @@ func TestListVectorStoreFiles(t *testing.T) {
 						fs[2]: "test2.txt",
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fs[0]: "test0.txt",
-						fs[1]: "test1.txt",
-						fs[2]: "test2.txt",
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{
 						vectorStoreID: collectionID,
@@ -255,7 +380,8 @@ func TestListVectorStoreFiles(t *testing.T) {
 					collectionName: vectorStoreID,
 				},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -345,11 +471,6 @@ func TestGetVectorStoreFile(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: "test.txt",
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{
 						vectorStoreID: collectionID,
@@ -359,7 +480,8 @@ func TestGetVectorStoreFile(t *testing.T) {
 					collectionName: vectorStoreID,
 				},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -434,11 +556,6 @@ func TestDeleteVectorStoreFile(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: "test.txt",
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{
 						vectorStoreID: collectionID,
@@ -448,7 +565,8 @@ func TestDeleteVectorStoreFile(t *testing.T) {
 					collectionName: vectorStoreID,
 				},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -479,3 +597,105 @@ func TestDeleteVectorStoreFile(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteVectorStoreFile_UsageBytes(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(
+		st,
+		&noopFileGetClient{},
+		&noopVStoreClient{
+			vs: map[string]int64{
+				vectorStoreID: collectionID,
+			},
+		},
+		&noopEmbedder{
+			collectionName: vectorStoreID,
+		},
+		modelName,
+		testModels,
+		config.QuotasConfig{},
+		testr.New(t),
+	)
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:        collectionID,
+		VectorStoreID:       vectorStoreID,
+		Name:                "collection0",
+		Status:              store.CollectionStatusCompleted,
+		ProjectID:           "default",
+		UsageBytes:          300,
+		FileCountsCompleted: 2,
+		FileCountsTotal:     2,
+	})
+	assert.NoError(t, err)
+	for _, f := range []*store.File{
+		{FileID: "file0", UsageBytes: 100},
+		{FileID: "file1", UsageBytes: 200},
+	} {
+		f.VectorStoreID = vectorStoreID
+		f.Status = store.FileStatusCompleted
+		err := st.CreateFile(f)
+		assert.NoError(t, err)
+	}
+
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.DeleteVectorStoreFile(ctx, &v1.DeleteVectorStoreFileRequest{
+		FileId:        "file0",
+		VectorStoreId: vectorStoreID,
+	})
+	assert.NoError(t, err)
+
+	c, err := st.GetCollectionByVectorStoreID("default", vectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), c.UsageBytes)
+	assert.Equal(t, int64(1), c.FileCountsCompleted)
+	assert.Equal(t, int64(1), c.FileCountsTotal)
+}
+
+func TestDeleteVectorStoreFile_ChunkDeletionFailed(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(
+		st,
+		&noopFileGetClient{},
+		&noopVStoreClient{vs: map[string]int64{vectorStoreID: collectionID}},
+		&noopEmbedder{deleteErr: fmt.Errorf("milvus error")},
+		modelName,
+		testModels,
+		config.QuotasConfig{},
+		testr.New(t),
+	)
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:        collectionID,
+		VectorStoreID:       vectorStoreID,
+		Name:                collectionName,
+		Status:              store.CollectionStatusCompleted,
+		ProjectID:           "default",
+		FileCountsCompleted: 1,
+		FileCountsTotal:     1,
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		Status:        store.FileStatusCompleted,
+	})
+	assert.NoError(t, err)
+
+	// The file is deleted even if its chunks cannot be deleted.
+	ctx := fakeAuthInto(context.Background())
+	_, err = srv.DeleteVectorStoreFile(ctx, &v1.DeleteVectorStoreFileRequest{
+		FileId:        fileID,
+		VectorStoreId: vectorStoreID,
+	})
+	assert.NoError(t, err)
+
+	_, err = st.GetFileByFileID(vectorStoreID, fileID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	c, err := st.GetCollectionByVectorStoreID("default", vectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), c.FileCountsCompleted)
+	assert.Equal(t, int64(0), c.FileCountsTotal)
+}
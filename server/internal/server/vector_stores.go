@@ -11,7 +11,10 @@ import (
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	embed "github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -22,6 +25,10 @@ const (
 	maxMetadataEntries     = 16
 	maxMetadataKeyLength   = 64
 	maxMetadataValueLength = 512
+
+	// lastActiveAtUpdateInterval is the minimum interval to update the last active time of a vector store. It avoids
+	// writing to the database on every search.
+	lastActiveAtUpdateInterval = time.Minute
 )
 
 // CreateVectorStore creates a new vector store.
@@ -42,6 +49,23 @@ func (s *S) CreateVectorStore(
 		return nil, err
 	}
 
+	index, err := toIndexConfig(req.IndexConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	model := req.EmbeddingModel
+	if model == "" {
+		model = s.model
+	}
+	dims, err := s.models.Dimensions(ctx, model)
+	if err != nil {
+		if errors.Is(err, embed.ErrUnknownModel) {
+			return nil, status.Errorf(codes.InvalidArgument, "embedding_model: %s", err)
+		}
+		return nil, status.Errorf(codes.Unavailable, "get dimensions of embedding model %q: %s", model, err)
+	}
+
 	// Pass the Authorization to the context for downstream gRPC calls.
 	ctx = auth.CarryMetadata(ctx)
 
@@ -62,18 +86,18 @@ func (s *S) CreateVectorStore(
 
 	// vector store ID is not a k8s resource, but the ID is used as a Milivus collection name,
 	// which can only contain numbers, letters and underscores.
-	vsID, err := id.GenerateIDForK8SResource("vs_")
+	vsID, err := id.GenerateIDForK8SResource(store.VectorStoreIDPrefix)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "generate id: %s", err)
 	}
 
-	cid, err := s.vstoreClient.CreateVectorStore(ctx, vsID, s.dimensions)
+	cid, err := s.vstoreClient.CreateVectorStore(ctx, vsID, dims, index)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(kenji): If the RPC fails after this point, a dangling Milvus collection will be left behind.
-	// We need some background cleaning processing.
+	// If the RPC fails after this point, the Milvus collection is left behind without a vector store. The
+	// reconciler drops it after the grace period.
 
 	c := &store.Collection{
 		VectorStoreID:       vsID,
@@ -84,8 +108,16 @@ func (s *S) CreateVectorStore(
 		ProjectID:           userInfo.ProjectID,
 		TenantID:            userInfo.TenantID,
 		LastActiveAt:        time.Now().Unix(),
-		EmbeddingModel:      s.model,
-		EmbeddingDimensions: s.dimensions,
+		EmbeddingModel:      model,
+		EmbeddingDimensions: dims,
+		MetricType:          string(index.MetricType),
+		IndexType:           string(index.IndexType),
+		IndexNList:          index.NList,
+		IndexNProbe:         index.NProbe,
+		IndexM:              index.M,
+		IndexEfConstruction: index.EfConstruction,
+		IndexEf:             index.Ef,
+		IndexSearchList:     index.SearchList,
 	}
 	if ea := req.ExpiresAfter; ea != nil {
 		if err := validateExpiresAfter(ea); err != nil {
@@ -93,6 +125,7 @@ func (s *S) CreateVectorStore(
 		}
 		c.Anchor = store.ExpiresAfterAnchor(ea.Anchor)
 		c.ExpiresAfterDays = ea.Days
+		c.ExpiresAt = c.ComputeExpiresAt()
 	}
 
 	var cms []*store.CollectionMetadata
@@ -105,9 +138,17 @@ func (s *S) CreateVectorStore(
 	}
 
 	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := s.validateVectorStoreQuotaInTransaction(tx, userInfo.ProjectID); err != nil {
+			return err
+		}
 		if err := store.CreateCollectionInTransaction(tx, c); err != nil {
 			return fmt.Errorf("create collection: %s", err)
 		}
+		if len(fs) > 0 {
+			if err := s.validateFileQuotaInTransaction(tx, userInfo.ProjectID, c.VectorStoreID, int64(len(fs))); err != nil {
+				return err
+			}
+		}
 
 		for _, cm := range cms {
 			if err := store.CreateCollectionMetadataInTransaction(tx, cm); err != nil {
@@ -116,6 +157,9 @@ func (s *S) CreateVectorStore(
 		}
 		return nil
 	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
 	}
 
@@ -124,26 +168,18 @@ func (s *S) CreateVectorStore(
 		return nil, err
 	}
 
-	fileCompleted := int64(0)
 	var errMsgs []string
 	for _, f := range fs {
-		if _, err := s.createVectorStoreFile(ctx, c, f, cs); err != nil {
+		if _, err := s.createVectorStoreFile(c, f, cs, nil); err != nil {
 			s.log.Error(err, "Failed to add file to vector store", "file", f.Id, "store", c.VectorStoreID)
 			errMsgs = append(errMsgs, fmt.Sprintf("file %q: %s", f.Id, err))
-			continue
 		}
-		fileCompleted++
 	}
 
 	c, err = s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, c.VectorStoreID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
 	}
-	c.FileCountsCompleted += fileCompleted
-	c.FileCountsTotal += fileCompleted
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
-	}
 
 	vsProto := toVectorStoreProto(c, cms)
 	if len(errMsgs) > 0 {
@@ -178,6 +214,42 @@ func validateMetadata(metadata map[string]string) error {
 	return nil
 }
 
+// toIndexConfig converts the index configuration in a request. The defaults are used for unset parameters.
+func toIndexConfig(ic *v1.IndexConfig) (milvus.IndexConfig, error) {
+	var index milvus.IndexConfig
+	if ic != nil {
+		index = milvus.IndexConfig{
+			MetricType:     entity.MetricType(strings.ToUpper(ic.MetricType)),
+			IndexType:      entity.IndexType(strings.ToUpper(ic.IndexType)),
+			NList:          int(ic.Nlist),
+			NProbe:         int(ic.Nprobe),
+			M:              int(ic.M),
+			EfConstruction: int(ic.EfConstruction),
+			Ef:             int(ic.Ef),
+			SearchList:     int(ic.SearchList),
+		}
+	}
+	index = index.WithDefaults()
+	if err := index.Validate(); err != nil {
+		return milvus.IndexConfig{}, status.Errorf(codes.InvalidArgument, "invalid index_config: %s", err)
+	}
+	return index, nil
+}
+
+// indexConfig returns the configuration of the vector index of a collection.
+func indexConfig(c *store.Collection) milvus.IndexConfig {
+	return milvus.IndexConfig{
+		MetricType:     entity.MetricType(c.MetricType),
+		IndexType:      entity.IndexType(c.IndexType),
+		NList:          c.IndexNList,
+		NProbe:         c.IndexNProbe,
+		M:              c.IndexM,
+		EfConstruction: c.IndexEfConstruction,
+		Ef:             c.IndexEf,
+		SearchList:     c.IndexSearchList,
+	}.WithDefaults()
+}
+
 func validateExpiresAfter(ea *v1.ExpiresAfter) error {
 	if ea.Anchor != "" && ea.Anchor != string(store.ExpiresAfterAnchorLastActiveAt) {
 		return status.Errorf(codes.InvalidArgument, "expires_after.anchor must be %q", store.ExpiresAfterAnchorLastActiveAt)
@@ -395,6 +467,7 @@ func (s *S) UpdateVectorStore(
 		if ea := req.ExpiresAfter; ea != nil {
 			c.Anchor = store.ExpiresAfterAnchor(ea.Anchor)
 			c.ExpiresAfterDays = ea.Days
+			c.ExpiresAt = c.ComputeExpiresAt()
 		}
 		if err := store.UpdateCollectionInTransaction(tx, c); err != nil {
 			return fmt.Errorf("update collection: %s", err)
@@ -426,8 +499,12 @@ func (s *S) DeleteVectorStore(
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	if err := s.validateVectorStore(req.Id, userInfo.ProjectID); err != nil {
-		return nil, err
+	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "collection %q not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
 	}
 
 	if err := s.store.Transaction(func(tx *gorm.DB) error {
@@ -440,16 +517,26 @@ func (s *S) DeleteVectorStore(
 		if err := store.DeleteAllFilesByVectorStoreIDInTransaction(tx, req.Id); err != nil {
 			return fmt.Errorf("delete files: %s", err)
 		}
+		if err := store.DeleteAllFileBatchesByVectorStoreIDInTransaction(tx, req.Id); err != nil {
+			return fmt.Errorf("delete file batches: %s", err)
+		}
+		if err := store.DeleteAllFileAttributesByVectorStoreIDInTransaction(tx, req.Id); err != nil {
+			return fmt.Errorf("delete file attributes: %s", err)
+		}
+		if err := store.DeleteAllChunkTextsByVectorStoreIDInTransaction(tx, req.Id); err != nil {
+			return fmt.Errorf("delete chunk texts: %s", err)
+		}
 		return nil
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
 	}
 
-	// TODO(kenji): If the RPC fails after this point, a dangling Milvus collection will be left behind.
-	// We need some background cleaning processing.
-
-	if err := s.vstoreClient.DeleteVectorStore(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "delete collection: %s", err)
+	// The collection of an expired vector store might have been dropped already. If the collection is not actually
+	// missing or the RPC fails after this point, the reconciler drops the collection after the grace period.
+	if !c.VectorsDropped && !c.VectorsMissing {
+		if err := s.vstoreClient.DeleteVectorStore(ctx, req.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "delete collection: %s", err)
+		}
 	}
 
 	return &v1.DeleteVectorStoreResponse{
@@ -486,7 +573,46 @@ func toVectorStoreProto(c *store.Collection, cms []*store.CollectionMetadata) *v
 		ExpiresAt:    c.ExpiresAt,
 		LastActiveAt: c.LastActiveAt,
 		Metadata:     m,
+		IndexConfig:  toIndexConfigProto(indexConfig(c)),
+
+		EmbeddingModel:      c.EmbeddingModel,
+		EmbeddingDimensions: int32(c.EmbeddingDimensions),
+	}
+}
+
+func toIndexConfigProto(index milvus.IndexConfig) *v1.IndexConfig {
+	return &v1.IndexConfig{
+		MetricType:     string(index.MetricType),
+		IndexType:      string(index.IndexType),
+		Nlist:          int32(index.NList),
+		Nprobe:         int32(index.NProbe),
+		M:              int32(index.M),
+		EfConstruction: int32(index.EfConstruction),
+		Ef:             int32(index.Ef),
+		SearchList:     int32(index.SearchList),
+	}
+}
+
+// validateNotExpired returns an error if the vector store has expired. Expired vector stores cannot be searched or
+// have files added.
+func validateNotExpired(c *store.Collection) error {
+	if c.Status == store.CollectionStatusExpired {
+		return status.Errorf(codes.FailedPrecondition, "vector store %q has expired", c.VectorStoreID)
+	}
+	return nil
+}
+
+// markActive updates the last active time of the vector store, which extends its expiration time. The update is
+// skipped if the vector store has been active recently.
+func markActive(st *store.S, c *store.Collection) error {
+	now := time.Now()
+	if now.Sub(time.Unix(c.LastActiveAt, 0)) < lastActiveAtUpdateInterval {
+		return nil
+	}
+	if err := st.UpdateCollectionLastActiveAt(c.VectorStoreID, now.Unix()); err != nil {
+		return status.Errorf(codes.Internal, "update last active at: %s", err)
 	}
+	return nil
 }
 
 // validateVectorStore checks if the specified vector is visible to the user.
@@ -8,11 +8,15 @@ import (
 	"github.com/go-logr/logr/testr"
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	embed "github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -23,11 +27,21 @@ const (
 	dimensions      = 10
 )
 
+var testModels = &fakeModelRegistry{
+	dims: map[string]int{
+		modelName:      dimensions,
+		"multilingual": 1024,
+	},
+}
+
 func TestCreateVectorStore(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.CreateVectorStoreRequest
-		wantErr bool
+		name      string
+		req       *v1.CreateVectorStoreRequest
+		wantIndex *v1.IndexConfig
+		wantModel string
+		wantDims  int
+		wantErr   bool
 	}{
 		{
 			name: "success",
@@ -52,6 +66,63 @@ func TestCreateVectorStore(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "hnsw index",
+			req: &v1.CreateVectorStoreRequest{
+				Name: vectorStoreName,
+				IndexConfig: &v1.IndexConfig{
+					MetricType: "cosine",
+					IndexType:  "HNSW",
+					M:          32,
+				},
+			},
+			wantIndex: &v1.IndexConfig{
+				MetricType:     "COSINE",
+				IndexType:      "HNSW",
+				M:              32,
+				EfConstruction: 200,
+				Ef:             64,
+			},
+		},
+		{
+			name: "embedding model",
+			req: &v1.CreateVectorStoreRequest{
+				Name:           vectorStoreName,
+				EmbeddingModel: "multilingual",
+			},
+			wantModel: "multilingual",
+			wantDims:  1024,
+		},
+		{
+			name: "unsupported embedding model",
+			req: &v1.CreateVectorStoreRequest{
+				Name:           vectorStoreName,
+				EmbeddingModel: "unknown",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid metric type",
+			req: &v1.CreateVectorStoreRequest{
+				Name: vectorStoreName,
+				IndexConfig: &v1.IndexConfig{
+					MetricType: "hamming",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid index param",
+			req: &v1.CreateVectorStoreRequest{
+				Name: vectorStoreName,
+				IndexConfig: &v1.IndexConfig{
+					IndexType: "IVF_FLAT",
+					Nlist:     8,
+					Nprobe:    16,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -59,6 +130,9 @@ func TestCreateVectorStore(t *testing.T) {
 			st, tearDown := store.NewTest(t)
 			defer tearDown()
 
+			vsc := &noopVStoreClient{
+				vs: map[string]int64{},
+			}
 			srv := New(
 				st,
 				&noopFileGetClient{
@@ -66,17 +140,11 @@ func TestCreateVectorStore(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: fileName,
-					},
-				},
-				&noopVStoreClient{
-					vs: map[string]int64{},
-				},
+				vsc,
 				&noopEmbedder{},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -89,7 +157,79 @@ func TestCreateVectorStore(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, vectorStoreName, resp.Name)
 			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.Total)
-			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.Completed)
+			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.InProgress)
+
+			wantIndex := tc.wantIndex
+			if wantIndex == nil {
+				wantIndex = &v1.IndexConfig{
+					MetricType: "L2",
+					IndexType:  "IVF_FLAT",
+					Nlist:      128,
+					Nprobe:     16,
+				}
+			}
+			assert.True(t, proto.Equal(wantIndex, resp.IndexConfig), "want %v, got %v", wantIndex, resp.IndexConfig)
+			assert.Equal(t, wantIndex.IndexType, string(vsc.indexes[resp.Id].IndexType))
+
+			// The index configuration is persisted.
+			got, err := srv.GetVectorStore(ctx, &v1.GetVectorStoreRequest{Id: resp.Id})
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(wantIndex, got.IndexConfig))
+
+			wantModel, wantDims := tc.wantModel, tc.wantDims
+			if wantModel == "" {
+				wantModel, wantDims = modelName, dimensions
+			}
+			assert.Equal(t, wantModel, got.EmbeddingModel)
+			assert.Equal(t, int32(wantDims), got.EmbeddingDimensions)
+			assert.Equal(t, wantDims, vsc.dims[resp.Id])
+		})
+	}
+}
+
+func TestCreateVectorStore_ModelRegistryError(t *testing.T) {
+	tcs := []struct {
+		name     string
+		models   *fakeModelRegistry
+		model    string
+		wantCode codes.Code
+	}{
+		{
+			name:     "unknown model",
+			models:   testModels,
+			model:    "unknown",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "llm engine unavailable",
+			models: &fakeModelRegistry{
+				err: fmt.Errorf("connection refused"),
+			},
+			model:    modelName,
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			srv := New(
+				st,
+				&noopFileGetClient{},
+				&noopVStoreClient{vs: map[string]int64{}},
+				&noopEmbedder{},
+				modelName,
+				tc.models,
+				config.QuotasConfig{},
+				testr.New(t),
+			)
+			_, err := srv.CreateVectorStore(fakeAuthInto(context.Background()), &v1.CreateVectorStoreRequest{
+				Name:           vectorStoreName,
+				EmbeddingModel: tc.model,
+			})
+			assert.Error(t, err)
+			assert.Equal(t, tc.wantCode, status.Code(err))
 		})
 	}
 }
@@ -111,17 +251,13 @@ func TestListVectorStores(t *testing.T) {
 				fileID: fileName,
 			},
 		},
-		&noopFileInternalClient{
-			ids: map[string]string{
-				fileID: fileName,
-			},
-		},
 		&noopVStoreClient{
 			vs: map[string]int64{},
 		},
 		&noopEmbedder{},
 		modelName,
-		dimensions,
+		testModels,
+		config.QuotasConfig{},
 		testr.New(t),
 	)
 
@@ -208,13 +344,13 @@ func TestGetVectorStore(t *testing.T) {
 	srv := New(
 		st,
 		&noopFileGetClient{},
-		&noopFileInternalClient{},
 		&noopVStoreClient{
 			vs: map[string]int64{},
 		},
 		&noopEmbedder{},
 		modelName,
-		dimensions,
+		testModels,
+		config.QuotasConfig{},
 		testr.New(t),
 	)
 
@@ -280,13 +416,13 @@ func TestGetVectorStoreByName(t *testing.T) {
 	srv := New(
 		st,
 		&noopFileGetClient{},
-		&noopFileInternalClient{},
 		&noopVStoreClient{
 			vs: map[string]int64{},
 		},
 		&noopEmbedder{},
 		modelName,
-		dimensions,
+		testModels,
+		config.QuotasConfig{},
 		testr.New(t),
 	)
 
@@ -394,17 +530,13 @@ func TestDeleteVectorStore(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: fileName,
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{},
 				},
 				&noopEmbedder{},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -484,17 +616,13 @@ func TestUpdateVectorStore(t *testing.T) {
 						fileID: fileName,
 					},
 				},
-				&noopFileInternalClient{
-					ids: map[string]string{
-						fileID: fileName,
-					},
-				},
 				&noopVStoreClient{
 					vs: map[string]int64{},
 				},
 				&noopEmbedder{},
 				modelName,
-				dimensions,
+				testModels,
+				config.QuotasConfig{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -528,28 +656,37 @@ func (c *noopFileGetClient) GetFile(ctx context.Context, in *fv1.GetFileRequest,
 	}, nil
 }
 
-type noopFileInternalClient struct {
-	ids map[string]string
+type fakeModelRegistry struct {
+	dims map[string]int
+	err  error
 }
 
-func (c *noopFileInternalClient) GetFilePath(ctx context.Context, in *fv1.GetFilePathRequest, opts ...grpc.CallOption) (*fv1.GetFilePathResponse, error) {
-	path, ok := c.ids[in.Id]
+func (r *fakeModelRegistry) Dimensions(ctx context.Context, model string) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	d, ok := r.dims[model]
 	if !ok {
-		return nil, status.Error(codes.NotFound, "file not found")
+		return 0, fmt.Errorf("%w: %q", embed.ErrUnknownModel, model)
 	}
-
-	return &fv1.GetFilePathResponse{
-		Path: path,
-	}, nil
+	return d, nil
 }
 
 type noopVStoreClient struct {
-	vs map[string]int64
+	vs      map[string]int64
+	indexes map[string]milvus.IndexConfig
+	dims    map[string]int
 }
 
-func (c *noopVStoreClient) CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error) {
+func (c *noopVStoreClient) CreateVectorStore(ctx context.Context, name string, dimensions int, index milvus.IndexConfig) (int64, error) {
 	newID := int64(len(c.vs) + 1)
 	c.vs[name] = newID
+	if c.indexes == nil {
+		c.indexes = map[string]milvus.IndexConfig{}
+		c.dims = map[string]int{}
+	}
+	c.indexes[name] = index
+	c.dims[name] = dimensions
 	return newID, nil
 }
 
@@ -564,26 +701,55 @@ func (c *noopVStoreClient) DeleteVectorStore(ctx context.Context, name string) e
 	return status.Error(codes.NotFound, "name not found")
 }
 
-func (c *noopVStoreClient) ListVectorStores(ctx context.Context) ([]int64, error) {
-	var ids []int64
-	for _, id := range c.vs {
-		ids = append(ids, id)
+func (c *noopVStoreClient) ListVectorStores(ctx context.Context) ([]string, error) {
+	var names []string
+	for name := range c.vs {
+		names = append(names, name)
 	}
-	return ids, nil
+	return names, nil
 }
 
 type noopEmbedder struct {
 	collectionName string
+	results        []*milvus.SearchResult
+	filter         *milvus.Filter
+	mode           milvus.SearchMode
+	// enableRerank is true if the embedder has a reranker.
+	enableRerank bool
+	rerank       bool
+	// deleteErr is returned when files are deleted.
+	deleteErr error
 }
 
-func (c *noopEmbedder) AddFile(ctx context.Context, collectionName, modelName, fileID, fileName, filePath string, chunkSizeTokens, chunkOverlapTokens int64) error {
-	if c.collectionName == "" || collectionName == c.collectionName {
-		return nil
+func (c *noopEmbedder) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	modelName, query string,
+	numDocs int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+	rerank bool,
+) ([]*milvus.SearchResult, error) {
+	if c.collectionName != "" && collectionName != c.collectionName {
+		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return fmt.Errorf("collection %s not found", collectionName)
+	if rerank && !c.enableRerank {
+		return nil, embed.ErrRerankerNotConfigured
+	}
+	c.filter = filter
+	c.mode = mode
+	c.rerank = rerank
+	if len(c.results) > numDocs {
+		return c.results[:numDocs], nil
+	}
+	return c.results, nil
 }
 
 func (c *noopEmbedder) DeleteFile(ctx context.Context, collectionName, fileID string) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
 	if c.collectionName == "" || collectionName == c.collectionName {
 		return nil
 	}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"gorm.io/gorm"
+)
+
+// ChunkText is the full text of a chunk that is too long to be stored in the vector store. The vector store holds
+// a prefix of the text, and the full text is looked up by the ID of the chunk in the vector store.
+type ChunkText struct {
+	gorm.Model
+
+	VectorStoreID string `gorm:"uniqueIndex:idx_chunk_text_vsid_chunk_id;index:idx_chunk_text_vsid_file_id"`
+	FileID        string `gorm:"index:idx_chunk_text_vsid_file_id"`
+	ChunkID       int64  `gorm:"uniqueIndex:idx_chunk_text_vsid_chunk_id"`
+
+	Text string
+}
+
+// CreateChunkTexts creates chunk texts.
+func (s *S) CreateChunkTexts(cts []*ChunkText) error {
+	if len(cts) == 0 {
+		return nil
+	}
+	if err := s.db.Create(cts).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListChunkTextsByChunkIDs lists the texts of the given chunks. Chunks whose texts are stored in the vector store
+// are not included.
+func (s *S) ListChunkTextsByChunkIDs(vectorStoreID string, chunkIDs []int64) ([]*ChunkText, error) {
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+	var cts []*ChunkText
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).
+		Where("chunk_id IN ?", chunkIDs).
+		Find(&cts).Error; err != nil {
+		return nil, err
+	}
+	return cts, nil
+}
+
+// DeleteAllChunkTextsByFileID deletes all chunk texts of the file.
+func (s *S) DeleteAllChunkTextsByFileID(vectorStoreID, fileID string) error {
+	if err := s.db.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Delete(&ChunkText{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteAllChunkTextsByVectorStoreIDInTransaction deletes all chunk texts of the collection.
+func DeleteAllChunkTextsByVectorStoreIDInTransaction(tx *gorm.DB, vectorStoreID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Delete(&ChunkText{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
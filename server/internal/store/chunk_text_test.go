@@ -0,0 +1,44 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkTexts(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	err := st.CreateChunkTexts([]*ChunkText{
+		{VectorStoreID: "vs0", FileID: "file0", ChunkID: 1, Text: "t1"},
+		{VectorStoreID: "vs0", FileID: "file0", ChunkID: 2, Text: "t2"},
+		{VectorStoreID: "vs0", FileID: "file1", ChunkID: 3, Text: "t3"},
+		{VectorStoreID: "vs1", FileID: "file0", ChunkID: 1, Text: "t4"},
+	})
+	assert.NoError(t, err)
+
+	got, err := st.ListChunkTextsByChunkIDs("vs0", []int64{1, 3, 4})
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	texts := map[int64]string{}
+	for _, ct := range got {
+		texts[ct.ChunkID] = ct.Text
+	}
+	assert.Equal(t, map[int64]string{1: "t1", 3: "t3"}, texts)
+
+	err = st.DeleteAllChunkTextsByFileID("vs0", "file0")
+	assert.NoError(t, err)
+	got, err = st.ListChunkTextsByChunkIDs("vs0", []int64{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	err = DeleteAllChunkTextsByVectorStoreIDInTransaction(st.db, "vs0")
+	assert.NoError(t, err)
+	got, err = st.ListChunkTextsByChunkIDs("vs0", []int64{3})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	got, err = st.ListChunkTextsByChunkIDs("vs1", []int64{1})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
@@ -23,6 +23,12 @@ const (
 
 	// ExpiresAfterAnchorLastActiveAt represents the anchor for the expiration time based on the last_active_at time.
 	ExpiresAfterAnchorLastActiveAt ExpiresAfterAnchor = "last_active_at"
+
+	// VectorStoreIDPrefix is the prefix of vector store IDs and thus the names of the Milvus collections of vector
+	// stores.
+	VectorStoreIDPrefix = "vs_"
+
+	secondsPerDay = 24 * 60 * 60
 )
 
 // Collection represents a collection.
@@ -45,6 +51,8 @@ type Collection struct {
 	// UsageBytes is the total number of bytes used by the files in the vector store.
 	UsageBytes int64
 
+	// The file counts and the usage are updated only by IncrementCollectionFileCountsInTransaction so that
+	// concurrent updates are not lost.
 	FileCountsInProgress int64
 	FileCountsCompleted  int64
 	FileCountsFailed     int64
@@ -63,12 +71,38 @@ type Collection struct {
 	// LastActiveAt is the Unix timestamp (in seconds) for when the vector store was last active.
 	LastActiveAt int64
 
+	// VectorsDropped is true if the Milvus collection of the expired vector store has been dropped.
+	VectorsDropped bool
+	// VectorsMissing is true if the Milvus collection of the vector store has not been found by the reconciler.
+	VectorsMissing bool
+
 	EmbeddingModel      string
 	EmbeddingDimensions int
 
+	// MetricType and IndexType are the metric and the type of the vector index. They are empty for collections
+	// created before the index became configurable, which use the defaults.
+	MetricType string
+	IndexType  string
+	// The build and search parameters of the vector index. Zero values mean the defaults.
+	IndexNList          int
+	IndexNProbe         int
+	IndexM              int
+	IndexEfConstruction int
+	IndexEf             int
+	IndexSearchList     int
+
 	Version int
 }
 
+// ComputeExpiresAt returns the expiration time of the collection computed from its last active time. It returns 0
+// if the collection does not expire.
+func (c *Collection) ComputeExpiresAt() int64 {
+	if c.ExpiresAfterDays <= 0 {
+		return 0
+	}
+	return c.LastActiveAt + c.ExpiresAfterDays*secondsPerDay
+}
+
 // CreateCollection creates a new collection.
 func (s *S) CreateCollection(c *Collection) error {
 	return CreateCollectionInTransaction(s.db, c)
@@ -84,8 +118,23 @@ func CreateCollectionInTransaction(tx *gorm.DB, c *Collection) error {
 
 // GetCollectionByVectorStoreID gets a collection.
 func (s *S) GetCollectionByVectorStoreID(projectID string, vectorStoreID string) (*Collection, error) {
+	return GetCollectionByVectorStoreIDInTransaction(s.db, projectID, vectorStoreID)
+}
+
+// GetCollectionByVectorStoreIDInTransaction gets a collection.
+func GetCollectionByVectorStoreIDInTransaction(tx *gorm.DB, projectID string, vectorStoreID string) (*Collection, error) {
 	var c Collection
-	if err := s.db.Where("vector_store_id = ? AND project_id = ?", vectorStoreID, projectID).Take(&c).Error; err != nil {
+	if err := tx.Where("vector_store_id = ? AND project_id = ?", vectorStoreID, projectID).Take(&c).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// GetCollectionByVectorStoreIDWithoutProject gets a collection without checking its project.
+// This is used by background processing that does not have a user context.
+func (s *S) GetCollectionByVectorStoreIDWithoutProject(vectorStoreID string) (*Collection, error) {
+	var c Collection
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).Take(&c).Error; err != nil {
 		return nil, err
 	}
 	return &c, nil
@@ -152,22 +201,18 @@ func (s *S) UpdateCollection(nc *Collection) error {
 	return UpdateCollectionInTransaction(s.db, nc)
 }
 
-// UpdateCollectionInTransaction updates the collection.
+// UpdateCollectionInTransaction updates the collection. The file counts and the usage are not updated.
 func UpdateCollectionInTransaction(tx *gorm.DB, nc *Collection) error {
 	result := tx.Model(&Collection{}).
 		Where("id = ?", nc.ID).
 		Where("version = ?", nc.Version).
 		Updates(map[string]interface{}{
-			"name":                    nc.Name,
-			"status":                  nc.Status,
-			"expires_after_days":      nc.ExpiresAfterDays,
-			"expires_at":              nc.ExpiresAt,
-			"file_counts_cancelled":   nc.FileCountsCancelled,
-			"file_counts_completed":   nc.FileCountsCompleted,
-			"file_counts_failed":      nc.FileCountsFailed,
-			"file_counts_in_progress": nc.FileCountsInProgress,
-			"file_counts_total":       nc.FileCountsTotal,
-			"version":                 nc.Version + 1,
+			"name":               nc.Name,
+			"status":             nc.Status,
+			"expires_after_days": nc.ExpiresAfterDays,
+			"expires_at":         nc.ExpiresAt,
+			"vectors_dropped":    nc.VectorsDropped,
+			"version":            nc.Version + 1,
 		})
 	if err := result.Error; err != nil {
 		return err
@@ -179,6 +224,136 @@ func UpdateCollectionInTransaction(tx *gorm.DB, nc *Collection) error {
 	return nil
 }
 
+// IncrementCollectionFileCountsInTransaction atomically adds the delta to the file counts of the collection and
+// usageBytes to its usage. The version is not changed so that the update does not conflict with other updates of the
+// collection.
+func IncrementCollectionFileCountsInTransaction(tx *gorm.DB, vectorStoreID string, d FileCountsDelta, usageBytes int64) error {
+	updates := d.updates()
+	updates["usage_bytes"] = gorm.Expr("usage_bytes + ?", usageBytes)
+	result := tx.Model(&Collection{}).
+		Where("vector_store_id = ?", vectorStoreID).
+		Updates(updates)
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateCollectionLastActiveAt updates the last active time of the collection and its expiration time. The version
+// is not changed so that the update does not conflict with other updates of the collection.
+func (s *S) UpdateCollectionLastActiveAt(vectorStoreID string, lastActiveAt int64) error {
+	if err := s.db.Model(&Collection{}).
+		Where("vector_store_id = ?", vectorStoreID).
+		Updates(map[string]interface{}{
+			"last_active_at": lastActiveAt,
+			"expires_at": gorm.Expr(
+				"CASE WHEN expires_after_days > 0 THEN ? + expires_after_days * ? ELSE 0 END",
+				lastActiveAt,
+				secondsPerDay,
+			),
+		}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateCollectionExpiresAt updates the expiration time of the collection unless the collection has become active
+// or its expiration policy has changed since it was read.
+func (s *S) UpdateCollectionExpiresAt(c *Collection) error {
+	if err := s.db.Model(&Collection{}).
+		Where("id = ?", c.ID).
+		Where("last_active_at = ?", c.LastActiveAt).
+		Where("expires_after_days = ?", c.ExpiresAfterDays).
+		Update("expires_at", c.ExpiresAt).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExpireCollection marks the collection as expired and sets its VectorsDropped. It fails with ErrConcurrentUpdate if
+// the collection has been updated or become active since it was read.
+func (s *S) ExpireCollection(c *Collection) error {
+	result := s.db.Model(&Collection{}).
+		Where("id = ?", c.ID).
+		Where("version = ?", c.Version).
+		Where("last_active_at = ?", c.LastActiveAt).
+		Updates(map[string]interface{}{
+			"status":          CollectionStatusExpired,
+			"vectors_dropped": c.VectorsDropped,
+			"version":         c.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("expire collection: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// ListCollectionsWithExpiration lists collections in all projects that have an expiration policy.
+func (s *S) ListCollectionsWithExpiration() ([]*Collection, error) {
+	var cs []*Collection
+	if err := s.db.Where("expires_after_days > 0").Order("id").Find(&cs).Error; err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// CountCollections counts the collections in the project.
+func (s *S) CountCollections(projectID string) (int64, error) {
+	return CountCollectionsInTransaction(s.db, projectID)
+}
+
+// CountCollectionsInTransaction counts the collections in the project.
+func CountCollectionsInTransaction(tx *gorm.DB, projectID string) (int64, error) {
+	var n int64
+	if err := tx.Model(&Collection{}).Where("project_id = ?", projectID).Count(&n).Error; err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// GetTotalUsageBytes returns the total usage of the collections in the project in bytes.
+func (s *S) GetTotalUsageBytes(projectID string) (int64, error) {
+	return GetTotalUsageBytesInTransaction(s.db, projectID)
+}
+
+// GetTotalUsageBytesInTransaction returns the total usage of the collections in the project in bytes.
+func GetTotalUsageBytesInTransaction(tx *gorm.DB, projectID string) (int64, error) {
+	var n int64
+	if err := tx.Model(&Collection{}).
+		Where("project_id = ?", projectID).
+		Select("COALESCE(SUM(usage_bytes), 0)").
+		Scan(&n).Error; err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// ListAllCollections lists collections in all projects.
+func (s *S) ListAllCollections() ([]*Collection, error) {
+	var cs []*Collection
+	if err := s.db.Order("id").Find(&cs).Error; err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// UpdateCollectionVectorsMissing updates whether the Milvus collection of the collection is missing. The version is
+// not changed so that the update does not conflict with other updates of the collection.
+func (s *S) UpdateCollectionVectorsMissing(id uint, missing bool) error {
+	if err := s.db.Model(&Collection{}).
+		Where("id = ?", id).
+		Update("vectors_missing", missing).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
 // DeleteCollection deletes the collection.
 func (s *S) DeleteCollection(projectID string, vectorStoreID string) error {
 	return DeleteCollectionInTransaction(s.db, projectID, vectorStoreID)
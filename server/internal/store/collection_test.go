@@ -198,7 +198,101 @@ func TestUpdateCollection(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, nc.Name, got.Name)
 	assert.Equal(t, nc.Status, got.Status)
-	assert.Equal(t, nc.FileCountsCompleted, got.FileCountsCompleted)
+	// The file counts are updated only by increments.
+	assert.Equal(t, int64(0), got.FileCountsCompleted)
+}
+
+func TestIncrementCollectionFileCounts(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const project = "project0"
+	c := Collection{
+		VectorStoreID:        "vs0",
+		CollectionID:         1,
+		Name:                 "collection0",
+		ProjectID:            project,
+		FileCountsInProgress: 2,
+		FileCountsTotal:      2,
+	}
+	err := st.CreateCollection(&c)
+	assert.NoError(t, err)
+
+	// Increments based on a stale copy of the collection are not lost.
+	var d FileCountsDelta
+	d.Add(FileStatusInProgress, -1)
+	d.Add(FileStatusCompleted, 1)
+	err = IncrementCollectionFileCountsInTransaction(st.db, c.VectorStoreID, d, 100)
+	assert.NoError(t, err)
+	err = IncrementCollectionFileCountsInTransaction(st.db, c.VectorStoreID, d, 200)
+	assert.NoError(t, err)
+	err = st.UpdateCollection(&c)
+	assert.NoError(t, err)
+
+	got, err := st.GetCollectionByVectorStoreID(project, c.VectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), got.FileCountsInProgress)
+	assert.Equal(t, int64(2), got.FileCountsCompleted)
+	assert.Equal(t, int64(2), got.FileCountsTotal)
+	assert.Equal(t, int64(300), got.UsageBytes)
+
+	err = IncrementCollectionFileCountsInTransaction(st.db, "unknown", d, 0)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestCollectionExpiration(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const project = "project0"
+
+	cs := []*Collection{
+		{VectorStoreID: "vs0", CollectionID: 1, Name: "c0", ProjectID: project, ExpiresAfterDays: 2, LastActiveAt: 100},
+		{VectorStoreID: "vs1", CollectionID: 2, Name: "c1", ProjectID: project},
+	}
+	for _, c := range cs {
+		err := st.CreateCollection(c)
+		assert.NoError(t, err)
+	}
+
+	got, err := st.ListCollectionsWithExpiration()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	c := got[0]
+	assert.Equal(t, "vs0", c.VectorStoreID)
+	assert.Equal(t, int64(100+2*secondsPerDay), c.ComputeExpiresAt())
+
+	c.ExpiresAt = c.ComputeExpiresAt()
+	err = st.UpdateCollectionExpiresAt(c)
+	assert.NoError(t, err)
+	got0, err := st.GetCollectionByVectorStoreID(project, "vs0")
+	assert.NoError(t, err)
+	assert.Equal(t, c.ExpiresAt, got0.ExpiresAt)
+
+	// The collection became active after it was read.
+	err = st.UpdateCollectionLastActiveAt("vs0", 200)
+	assert.NoError(t, err)
+	got0, err = st.GetCollectionByVectorStoreID(project, "vs0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), got0.LastActiveAt)
+	assert.Equal(t, int64(200+2*secondsPerDay), got0.ExpiresAt)
+	assert.Equal(t, c.Version, got0.Version)
+
+	err = st.ExpireCollection(c)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	err = st.ExpireCollection(got0)
+	assert.NoError(t, err)
+	got0, err = st.GetCollectionByVectorStoreID(project, "vs0")
+	assert.NoError(t, err)
+	assert.Equal(t, CollectionStatusExpired, got0.Status)
+
+	// The collection without an expiration policy does not expire.
+	err = st.UpdateCollectionLastActiveAt("vs1", 200)
+	assert.NoError(t, err)
+	got1, err := st.GetCollectionByVectorStoreID(project, "vs1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), got1.ExpiresAt)
 }
 
 func TestDeleteCollection(t *testing.T) {
@@ -258,3 +352,34 @@ func TestCreateCollection_SameName(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, gerrors.IsUniqueConstraintViolation(err))
 }
+
+func TestCountCollectionsAndTotalUsageBytes(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	n, err := st.CountCollections("p0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+	usage, err := st.GetTotalUsageBytes("p0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), usage)
+
+	for i, c := range []*Collection{
+		{ProjectID: "p0", UsageBytes: 100},
+		{ProjectID: "p0", UsageBytes: 200},
+		{ProjectID: "p1", UsageBytes: 400},
+	} {
+		c.CollectionID = int64(i)
+		c.VectorStoreID = fmt.Sprintf("vs%d", i)
+		c.Name = fmt.Sprintf("c%d", i)
+		err := st.CreateCollection(c)
+		assert.NoError(t, err)
+	}
+
+	n, err = st.CountCollections("p0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	usage, err = st.GetTotalUsageBytes("p0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), usage)
+}
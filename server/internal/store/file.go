@@ -1,6 +1,7 @@
 package store
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -36,8 +37,44 @@ const (
 	ChunkingStrategyTypeAuto ChunkingStrategyType = "auto"
 	// ChunkingStrategyTypeStatic represents the static chunking strategy.
 	ChunkingStrategyTypeStatic ChunkingStrategyType = "static"
+	// ChunkingStrategyTypeSemantic represents the semantic chunking strategy.
+	ChunkingStrategyTypeSemantic ChunkingStrategyType = "semantic"
 )
 
+// FileCountsDelta is a change in the numbers of files of a collection or a file batch.
+type FileCountsDelta struct {
+	InProgress int64
+	Completed  int64
+	Failed     int64
+	Cancelled  int64
+	Total      int64
+}
+
+// Add adds n to the number of files in the status. The total is not changed.
+func (d *FileCountsDelta) Add(status FileStatus, n int64) {
+	switch status {
+	case FileStatusInProgress:
+		d.InProgress += n
+	case FileStatusCompleted:
+		d.Completed += n
+	case FileStatusFailed:
+		d.Failed += n
+	case FileStatusCancelled:
+		d.Cancelled += n
+	}
+}
+
+// updates returns the column updates that atomically add the delta to the file counts.
+func (d *FileCountsDelta) updates() map[string]interface{} {
+	return map[string]interface{}{
+		"file_counts_in_progress": gorm.Expr("file_counts_in_progress + ?", d.InProgress),
+		"file_counts_completed":   gorm.Expr("file_counts_completed + ?", d.Completed),
+		"file_counts_failed":      gorm.Expr("file_counts_failed + ?", d.Failed),
+		"file_counts_cancelled":   gorm.Expr("file_counts_cancelled + ?", d.Cancelled),
+		"file_counts_total":       gorm.Expr("file_counts_total + ?", d.Total),
+	}
+}
+
 // File represents a file.
 type File struct {
 	gorm.Model
@@ -47,25 +84,47 @@ type File struct {
 	// FileID is the file ID.
 	FileID string `gorm:"uniqueIndex:idx_file_vector_store_id_file_id"`
 
+	// Filename is the name of the original file. It is used to show the source of search results.
+	Filename string
+
+	// BatchID is the ID of the file batch that added the file. It is empty if the file was added individually.
+	BatchID string `gorm:"index"`
+
 	// UsageBytes is the total vector store usage in bytes. Note that this may be different from the original file size.
 	UsageBytes int64
 
-	// TODO(guangrui): handle status update.
-	Status FileStatus
+	Status FileStatus `gorm:"index"`
 
 	LastErrorCode    LastErrorCode
 	LastErrorMessage string
 
 	ChunkingStrategyType ChunkingStrategyType
-	MaxChunkSizeTokens   int64
-	ChunkOverlapTokens   int64
+	// MaxChunkSizeTokens and ChunkOverlapTokens are zero for the auto chunking strategy until the file is processed.
+	MaxChunkSizeTokens int64
+	ChunkOverlapTokens int64
+	// ChunkingMethod is the method chosen by the auto chunking strategy to split the file.
+	ChunkingMethod string
+	// SemanticBreakpointPercentile and SemanticBufferSize are the parameters of the semantic chunking strategy.
+	SemanticBreakpointPercentile int
+	SemanticBufferSize           int
+
+	// ProcessingExpiresAt is the Unix timestamp (in seconds) until which an ingestion worker holds the file.
+	// An in-progress file whose ProcessingExpiresAt has passed is picked up again by a worker.
+	ProcessingExpiresAt int64
+	// Attempts is the number of times ingestion workers have claimed the file.
+	Attempts int
 
 	Version int
 }
 
 // CreateFile creates a new file.
 func (s *S) CreateFile(f *File) error {
-	if err := s.db.Create(f).Error; err != nil {
+	return CreateFileInTransaction(s.db, f)
+}
+
+// CreateFileInTransaction creates a new file.
+func CreateFileInTransaction(tx *gorm.DB, f *File) error {
+	if err := tx.Create(f).Error; err != nil {
 		return err
 	}
 	return nil
@@ -73,8 +132,13 @@ func (s *S) CreateFile(f *File) error {
 
 // GetFileByFileID gets a file.
 func (s *S) GetFileByFileID(vectorStoreID, fileID string) (*File, error) {
+	return GetFileByFileIDInTransaction(s.db, vectorStoreID, fileID)
+}
+
+// GetFileByFileIDInTransaction gets a file.
+func GetFileByFileIDInTransaction(tx *gorm.DB, vectorStoreID, fileID string) (*File, error) {
 	var f File
-	if err := s.db.Where("file_id = ?", fileID).
+	if err := tx.Where("file_id = ?", fileID).
 		Where("vector_store_id = ?", vectorStoreID).
 		Take(&f).Error; err != nil {
 		return nil, err
@@ -93,6 +157,34 @@ func (s *S) ListFiles(vectorStoreID string) ([]*File, error) {
 	return fs, nil
 }
 
+// ListFilesByFileIDs lists the given files of the vector store. Files that do not exist are not included.
+func (s *S) ListFilesByFileIDs(vectorStoreID string, fileIDs []string) ([]*File, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+	var fs []*File
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id IN ?", fileIDs).
+		Find(&fs).Error; err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// ListPendingFiles lists in-progress files that are not held by any ingestion worker.
+func (s *S) ListPendingFiles(now int64, limit int) ([]*File, error) {
+	var fs []*File
+	if err := s.db.
+		Where("status = ?", FileStatusInProgress).
+		Where("processing_expires_at < ?", now).
+		Order("id").
+		Limit(limit).
+		Find(&fs).Error; err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
 // ListFilesWithPagination finds files with pagination. Files are returned in the order of created_at.
 func (s *S) ListFilesWithPagination(
 	vectorStoreID string,
@@ -101,8 +193,37 @@ func (s *S) ListFilesWithPagination(
 	order string,
 	limit int,
 ) ([]*File, bool, error) {
-	var fs []*File
 	q := s.db.Where("vector_store_id = ?", vectorStoreID)
+	return listFilesWithPagination(q, afterCreatedAt, afterID, order, limit)
+}
+
+// ListFilesInBatchWithPagination finds files added by the batch with pagination. Files are returned in the order of created_at.
+// If status is not empty, only files with the status are returned.
+func (s *S) ListFilesInBatchWithPagination(
+	vectorStoreID string,
+	batchID string,
+	status FileStatus,
+	afterCreatedAt time.Time,
+	afterID uint,
+	order string,
+	limit int,
+) ([]*File, bool, error) {
+	q := s.db.Where("vector_store_id = ?", vectorStoreID).
+		Where("batch_id = ?", batchID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	return listFilesWithPagination(q, afterCreatedAt, afterID, order, limit)
+}
+
+func listFilesWithPagination(
+	q *gorm.DB,
+	afterCreatedAt time.Time,
+	afterID uint,
+	order string,
+	limit int,
+) ([]*File, bool, error) {
+	var fs []*File
 	isAsc := order == "asc"
 
 	if afterCreatedAt != (time.Time{}) {
@@ -131,6 +252,56 @@ func (s *S) ListFilesWithPagination(
 	return fs, hasMore, nil
 }
 
+// UpdateFile updates the file.
+func (s *S) UpdateFile(nf *File) error {
+	return UpdateFileInTransaction(s.db, nf)
+}
+
+// UpdateFileInTransaction updates the file.
+func UpdateFileInTransaction(tx *gorm.DB, nf *File) error {
+	result := tx.Model(&File{}).
+		Where("id = ?", nf.ID).
+		Where("version = ?", nf.Version).
+		Updates(map[string]interface{}{
+			"status":                nf.Status,
+			"filename":              nf.Filename,
+			"usage_bytes":           nf.UsageBytes,
+			"last_error_code":       nf.LastErrorCode,
+			"last_error_message":    nf.LastErrorMessage,
+			"max_chunk_size_tokens": nf.MaxChunkSizeTokens,
+			"chunk_overlap_tokens":  nf.ChunkOverlapTokens,
+			"chunking_method":       nf.ChunkingMethod,
+			"processing_expires_at": nf.ProcessingExpiresAt,
+			"attempts":              nf.Attempts,
+			"version":               nf.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update file: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// CancelInProgressFilesInBatchInTransaction cancels all in-progress files of the batch and returns the number of
+// cancelled files. The versions of the files are incremented so that ingestion workers processing them discard their results.
+func CancelInProgressFilesInBatchInTransaction(tx *gorm.DB, vectorStoreID, batchID string) (int64, error) {
+	result := tx.Model(&File{}).
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("batch_id = ?", batchID).
+		Where("status = ?", FileStatusInProgress).
+		Updates(map[string]interface{}{
+			"status":                FileStatusCancelled,
+			"processing_expires_at": 0,
+			"version":               gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
+
 // DeleteFile deletes the file.
 func (s *S) DeleteFile(vectorStoreID, fileID string) error {
 	result := s.db.Unscoped().
@@ -146,6 +317,22 @@ func (s *S) DeleteFile(vectorStoreID, fileID string) error {
 	return nil
 }
 
+// DeleteFileWithVersionInTransaction deletes the file. It fails with ErrConcurrentUpdate if the file has been updated
+// or deleted since it was read.
+func DeleteFileWithVersionInTransaction(tx *gorm.DB, f *File) error {
+	result := tx.Unscoped().
+		Where("id = ?", f.ID).
+		Where("version = ?", f.Version).
+		Delete(&File{})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("delete file: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // DeleteAllFilesByVectorStoreID deletes all files of the collection.
 func (s *S) DeleteAllFilesByVectorStoreID(vectorStoreID string) error {
 	return DeleteAllFilesByVectorStoreIDInTransaction(s.db, vectorStoreID)
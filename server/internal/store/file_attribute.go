@@ -0,0 +1,63 @@
+package store
+
+import (
+	"gorm.io/gorm"
+)
+
+// FileAttribute represents an attribute of a file in a vector store. Attributes are copied onto the chunks of the file
+// so that search results can be filtered by them.
+type FileAttribute struct {
+	gorm.Model
+
+	VectorStoreID string `gorm:"uniqueIndex:idx_fileattr_vsid_file_id_key"`
+	FileID        string `gorm:"uniqueIndex:idx_fileattr_vsid_file_id_key"`
+
+	Key   string `gorm:"uniqueIndex:idx_fileattr_vsid_file_id_key"`
+	Value string
+}
+
+// CreateFileAttributeInTransaction creates a new file attribute.
+func CreateFileAttributeInTransaction(tx *gorm.DB, fa *FileAttribute) error {
+	if err := tx.Create(fa).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListFileAttributes lists attributes of a file.
+func (s *S) ListFileAttributes(vectorStoreID, fileID string) ([]*FileAttribute, error) {
+	var fas []*FileAttribute
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Order("key").
+		Find(&fas).Error; err != nil {
+		return nil, err
+	}
+	return fas, nil
+}
+
+// DeleteAllFileAttributesByFileID deletes all attributes of the file.
+func (s *S) DeleteAllFileAttributesByFileID(vectorStoreID, fileID string) error {
+	return DeleteAllFileAttributesByFileIDInTransaction(s.db, vectorStoreID, fileID)
+}
+
+// DeleteAllFileAttributesByFileIDInTransaction deletes all attributes of the file.
+func DeleteAllFileAttributesByFileIDInTransaction(tx *gorm.DB, vectorStoreID, fileID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Delete(&FileAttribute{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteAllFileAttributesByVectorStoreIDInTransaction deletes all file attributes of the collection.
+func DeleteAllFileAttributesByVectorStoreIDInTransaction(tx *gorm.DB, vectorStoreID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Delete(&FileAttribute{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileAttributes(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	fas := []*FileAttribute{
+		{VectorStoreID: "vs0", FileID: "file0", Key: "k1", Value: "v1"},
+		{VectorStoreID: "vs0", FileID: "file0", Key: "k0", Value: "v0"},
+		{VectorStoreID: "vs0", FileID: "file1", Key: "k0", Value: "v2"},
+		{VectorStoreID: "vs1", FileID: "file0", Key: "k0", Value: "v3"},
+	}
+	for _, fa := range fas {
+		err := CreateFileAttributeInTransaction(st.db, fa)
+		assert.NoError(t, err)
+	}
+
+	got, err := st.ListFileAttributes("vs0", "file0")
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "k0", got[0].Key)
+	assert.Equal(t, "v0", got[0].Value)
+	assert.Equal(t, "k1", got[1].Key)
+
+	err = st.DeleteAllFileAttributesByFileID("vs0", "file0")
+	assert.NoError(t, err)
+	got, err = st.ListFileAttributes("vs0", "file0")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	got, err = st.ListFileAttributes("vs0", "file1")
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	err = DeleteAllFileAttributesByVectorStoreIDInTransaction(st.db, "vs0")
+	assert.NoError(t, err)
+	got, err = st.ListFileAttributes("vs0", "file1")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	got, err = st.ListFileAttributes("vs1", "file0")
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// FileBatchStatus represents the status of a file batch.
+type FileBatchStatus string
+
+const (
+	// FileBatchStatusInProgress represents the in_progress status.
+	FileBatchStatusInProgress FileBatchStatus = "in_progress"
+	// FileBatchStatusCompleted represents the completed status.
+	FileBatchStatusCompleted FileBatchStatus = "completed"
+	// FileBatchStatusCancelled represents the cancelled status.
+	FileBatchStatusCancelled FileBatchStatus = "cancelled"
+	// FileBatchStatusFailed represents the failed status.
+	FileBatchStatusFailed FileBatchStatus = "failed"
+)
+
+// FileBatch represents a batch of files added to a vector store at once.
+type FileBatch struct {
+	gorm.Model
+
+	// BatchID is the ID of the file batch that is externally visible in the API.
+	BatchID string `gorm:"uniqueIndex"`
+
+	VectorStoreID string `gorm:"index"`
+
+	Status FileBatchStatus
+
+	// The file counts are updated only by IncrementFileBatchFileCountsInTransaction so that concurrent updates are
+	// not lost.
+	FileCountsInProgress int64
+	FileCountsCompleted  int64
+	FileCountsFailed     int64
+	FileCountsCancelled  int64
+	FileCountsTotal      int64
+
+	Version int
+}
+
+// CreateFileBatchInTransaction creates a new file batch.
+func CreateFileBatchInTransaction(tx *gorm.DB, b *FileBatch) error {
+	if err := tx.Create(b).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetFileBatchByBatchID gets a file batch.
+func (s *S) GetFileBatchByBatchID(vectorStoreID, batchID string) (*FileBatch, error) {
+	return GetFileBatchByBatchIDInTransaction(s.db, vectorStoreID, batchID)
+}
+
+// GetFileBatchByBatchIDInTransaction gets a file batch.
+func GetFileBatchByBatchIDInTransaction(tx *gorm.DB, vectorStoreID, batchID string) (*FileBatch, error) {
+	var b FileBatch
+	if err := tx.Where("batch_id = ?", batchID).
+		Where("vector_store_id = ?", vectorStoreID).
+		Take(&b).Error; err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// UpdateFileBatch updates the file batch.
+func (s *S) UpdateFileBatch(nb *FileBatch) error {
+	return UpdateFileBatchInTransaction(s.db, nb)
+}
+
+// UpdateFileBatchInTransaction updates the file batch. The file counts are not updated.
+func UpdateFileBatchInTransaction(tx *gorm.DB, nb *FileBatch) error {
+	result := tx.Model(&FileBatch{}).
+		Where("id = ?", nb.ID).
+		Where("version = ?", nb.Version).
+		Updates(map[string]interface{}{
+			"status":  nb.Status,
+			"version": nb.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update file batch: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// IncrementFileBatchFileCountsInTransaction atomically adds the delta to the file counts of the file batch. An
+// in-progress batch is completed, or failed if all of its files have failed, once none of its files is in progress.
+// The version is not changed so that the update does not conflict with other updates of the file batch.
+func IncrementFileBatchFileCountsInTransaction(tx *gorm.DB, vectorStoreID, batchID string, d FileCountsDelta) error {
+	result := tx.Model(&FileBatch{}).
+		Where("batch_id = ?", batchID).
+		Where("vector_store_id = ?", vectorStoreID).
+		Updates(d.updates())
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	// Update the status in a separate statement as databases differ in whether SET clauses see the updated counts.
+	// The version is bumped so that version-checked updates of the batch do not overwrite the status.
+	if err := tx.Model(&FileBatch{}).
+		Where("batch_id = ?", batchID).
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("status = ?", FileBatchStatusInProgress).
+		Where("file_counts_in_progress <= 0").
+		Updates(map[string]interface{}{
+			"status": gorm.Expr(
+				"CASE WHEN file_counts_total > 0 AND file_counts_failed = file_counts_total THEN ? ELSE ? END",
+				FileBatchStatusFailed,
+				FileBatchStatusCompleted,
+			),
+			"version": gorm.Expr("version + 1"),
+		}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteAllFileBatchesByVectorStoreIDInTransaction deletes all file batches of the collection.
+func DeleteAllFileBatchesByVectorStoreIDInTransaction(tx *gorm.DB, vectorStoreID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Delete(&FileBatch{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
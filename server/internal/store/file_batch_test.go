@@ -0,0 +1,141 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFileBatch(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		batchID       = "batch0"
+		vectorStoreID = "vs0"
+	)
+
+	_, err := st.GetFileBatchByBatchID(vectorStoreID, batchID)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	b := &FileBatch{
+		BatchID:              batchID,
+		VectorStoreID:        vectorStoreID,
+		Status:               FileBatchStatusInProgress,
+		FileCountsInProgress: 2,
+		FileCountsTotal:      2,
+	}
+	err = CreateFileBatchInTransaction(st.db, b)
+	assert.NoError(t, err)
+
+	got, err := st.GetFileBatchByBatchID(vectorStoreID, batchID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got.FileCountsInProgress)
+
+	// Different vector store.
+	_, err = st.GetFileBatchByBatchID("different", batchID)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	got.Status = FileBatchStatusCancelled
+	err = st.UpdateFileBatch(got)
+	assert.NoError(t, err)
+
+	// The version is stale.
+	err = st.UpdateFileBatch(got)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
+	got, err = st.GetFileBatchByBatchID(vectorStoreID, batchID)
+	assert.NoError(t, err)
+	assert.Equal(t, FileBatchStatusCancelled, got.Status)
+	assert.Equal(t, 1, got.Version)
+
+	err = DeleteAllFileBatchesByVectorStoreIDInTransaction(st.db, vectorStoreID)
+	assert.NoError(t, err)
+	_, err = st.GetFileBatchByBatchID(vectorStoreID, batchID)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestIncrementFileBatchFileCounts(t *testing.T) {
+	const (
+		batchID       = "batch0"
+		vectorStoreID = "vs0"
+	)
+
+	tcs := []struct {
+		name       string
+		status     FileBatchStatus
+		deltas     []FileStatus
+		wantStatus FileBatchStatus
+	}{
+		{
+			name:       "in progress",
+			status:     FileBatchStatusInProgress,
+			deltas:     []FileStatus{FileStatusCompleted},
+			wantStatus: FileBatchStatusInProgress,
+		},
+		{
+			name:       "completed",
+			status:     FileBatchStatusInProgress,
+			deltas:     []FileStatus{FileStatusCompleted, FileStatusFailed},
+			wantStatus: FileBatchStatusCompleted,
+		},
+		{
+			name:       "failed",
+			status:     FileBatchStatusInProgress,
+			deltas:     []FileStatus{FileStatusFailed, FileStatusFailed},
+			wantStatus: FileBatchStatusFailed,
+		},
+		{
+			name:       "cancelled",
+			status:     FileBatchStatusCancelled,
+			deltas:     []FileStatus{FileStatusCompleted, FileStatusCompleted},
+			wantStatus: FileBatchStatusCancelled,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, teardown := NewTest(t)
+			defer teardown()
+
+			b := &FileBatch{
+				BatchID:              batchID,
+				VectorStoreID:        vectorStoreID,
+				Status:               tc.status,
+				FileCountsInProgress: 2,
+				FileCountsTotal:      2,
+			}
+			err := CreateFileBatchInTransaction(st.db, b)
+			assert.NoError(t, err)
+
+			for _, s := range tc.deltas {
+				var d FileCountsDelta
+				d.Add(FileStatusInProgress, -1)
+				d.Add(s, 1)
+				err := IncrementFileBatchFileCountsInTransaction(st.db, vectorStoreID, batchID, d)
+				assert.NoError(t, err)
+			}
+
+			got, err := st.GetFileBatchByBatchID(vectorStoreID, batchID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Status)
+			assert.Equal(t, int64(2-len(tc.deltas)), got.FileCountsInProgress)
+
+			// The version is bumped only when the status changes so that a stale copy of the batch cannot overwrite
+			// the status.
+			if tc.wantStatus == tc.status {
+				assert.Equal(t, b.Version, got.Version)
+				return
+			}
+			assert.Equal(t, b.Version+1, got.Version)
+			b.Status = FileBatchStatusCancelled
+			err = UpdateFileBatchInTransaction(st.db, b)
+			assert.ErrorIs(t, err, ErrConcurrentUpdate)
+		})
+	}
+}
@@ -69,6 +69,19 @@ func TestCreateAndListFiles(t *testing.T) {
 	got, err := st.ListFiles(vectorStoreID)
 	assert.NoError(t, err)
 	assert.Len(t, got, 3)
+
+	got, err = st.ListFilesByFileIDs(vectorStoreID, []string{"fileID0", "fileID2", "missing"})
+	assert.NoError(t, err)
+	var ids []string
+	for _, f := range got {
+		assert.Equal(t, vectorStoreID, f.VectorStoreID)
+		ids = append(ids, f.FileID)
+	}
+	assert.ElementsMatch(t, []string{"fileID0", "fileID2"}, ids)
+
+	got, err = st.ListFilesByFileIDs(vectorStoreID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
 }
 
 func TestListFilesWithPagination(t *testing.T) {
@@ -127,6 +140,167 @@ func TestListFilesWithPagination(t *testing.T) {
 	}
 }
 
+func TestListPendingFiles(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const vectorStoreID = "vs0"
+
+	fs := []*File{
+		{
+			FileID:        "file0",
+			VectorStoreID: vectorStoreID,
+			Status:        FileStatusInProgress,
+		},
+		{
+			FileID:              "file1",
+			VectorStoreID:       vectorStoreID,
+			Status:              FileStatusInProgress,
+			ProcessingExpiresAt: 100,
+		},
+		{
+			FileID:        "file2",
+			VectorStoreID: vectorStoreID,
+			Status:        FileStatusCompleted,
+		},
+	}
+	for _, f := range fs {
+		err := st.CreateFile(f)
+		assert.NoError(t, err)
+	}
+
+	got, err := st.ListPendingFiles(50, 10)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "file0", got[0].FileID)
+
+	got, err = st.ListPendingFiles(200, 10)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	got, err = st.ListPendingFiles(200, 1)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestUpdateFile(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		fileID        = "file0"
+		vectorStoreID = "vs0"
+	)
+
+	f := File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		Status:        FileStatusInProgress,
+	}
+	err := st.CreateFile(&f)
+	assert.NoError(t, err)
+
+	nf := f
+	nf.Status = FileStatusCompleted
+	err = st.UpdateFile(&nf)
+	assert.NoError(t, err)
+
+	got, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, FileStatusCompleted, got.Status)
+	assert.Equal(t, 1, got.Version)
+
+	// The version is stale.
+	err = st.UpdateFile(&nf)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+}
+
+func TestCancelInProgressFilesInBatch(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		vectorStoreID = "vs0"
+		batchID       = "batch0"
+	)
+
+	fs := []*File{
+		{FileID: "file0", BatchID: batchID, Status: FileStatusInProgress},
+		{FileID: "file1", BatchID: batchID, Status: FileStatusCompleted},
+		{FileID: "file2", BatchID: batchID, Status: FileStatusInProgress},
+		{FileID: "file3", BatchID: "batch1", Status: FileStatusInProgress},
+		{FileID: "file4", Status: FileStatusInProgress},
+	}
+	for _, f := range fs {
+		f.VectorStoreID = vectorStoreID
+		err := st.CreateFile(f)
+		assert.NoError(t, err)
+	}
+
+	n, err := CancelInProgressFilesInBatchInTransaction(st.db, vectorStoreID, batchID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	want := map[string]FileStatus{
+		"file0": FileStatusCancelled,
+		"file1": FileStatusCompleted,
+		"file2": FileStatusCancelled,
+		"file3": FileStatusInProgress,
+		"file4": FileStatusInProgress,
+	}
+	for fileID, status := range want {
+		got, err := st.GetFileByFileID(vectorStoreID, fileID)
+		assert.NoError(t, err)
+		assert.Equal(t, status, got.Status, fileID)
+		if status == FileStatusCancelled {
+			assert.Equal(t, 1, got.Version)
+		}
+	}
+}
+
+func TestListFilesInBatchWithPagination(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		vectorStoreID = "vs0"
+		batchID       = "batch0"
+	)
+
+	fs := []*File{
+		{FileID: "file0", BatchID: batchID, Status: FileStatusCompleted},
+		{FileID: "file1", BatchID: batchID, Status: FileStatusFailed},
+		{FileID: "file2", BatchID: batchID, Status: FileStatusCompleted},
+		{FileID: "file3", Status: FileStatusCompleted},
+	}
+	for _, f := range fs {
+		f.VectorStoreID = vectorStoreID
+		err := st.CreateFile(f)
+		assert.NoError(t, err)
+	}
+
+	got, hasMore, err := st.ListFilesInBatchWithPagination(vectorStoreID, batchID, "", time.Time{}, 0, "asc", 2)
+	assert.NoError(t, err)
+	assert.True(t, hasMore)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "file0", got[0].FileID)
+	assert.Equal(t, "file1", got[1].FileID)
+
+	got, hasMore, err = st.ListFilesInBatchWithPagination(vectorStoreID, batchID, "", got[1].CreatedAt, got[1].ID, "asc", 2)
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "file2", got[0].FileID)
+
+	got, hasMore, err = st.ListFilesInBatchWithPagination(vectorStoreID, batchID, FileStatusCompleted, time.Time{}, 0, "asc", 10)
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "file0", got[0].FileID)
+	assert.Equal(t, "file2", got[1].FileID)
+}
+
 func TestDeleteFile(t *testing.T) {
 	st, teardown := NewTest(t)
 	defer teardown()
@@ -152,6 +326,42 @@ func TestDeleteFile(t *testing.T) {
 	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
 }
 
+func TestDeleteFileWithVersion(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		fileID        = "file0"
+		vectorStoreID = "vs0"
+	)
+
+	f := File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		Status:        FileStatusInProgress,
+	}
+	err := st.CreateFile(&f)
+	assert.NoError(t, err)
+
+	// The file has been updated since it was read.
+	stale := f
+	f.Status = FileStatusCompleted
+	err = st.UpdateFile(&f)
+	assert.NoError(t, err)
+	err = DeleteFileWithVersionInTransaction(st.db, &stale)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
+	cur, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	err = DeleteFileWithVersionInTransaction(st.db, cur)
+	assert.NoError(t, err)
+
+	_, err = st.GetFileByFileID(vectorStoreID, fileID)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
 func TestDeleteAllFilesByVectorStoreID(t *testing.T) {
 	st, teardown := NewTest(t)
 	defer teardown()
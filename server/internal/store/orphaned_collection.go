@@ -0,0 +1,45 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrphanedCollection represents a Milvus collection without a vector store. It is recorded by the reconciler so that
+// the grace period before dropping the collection is kept across restarts and shared by server replicas.
+type OrphanedCollection struct {
+	gorm.Model
+
+	// Name is the name of the Milvus collection.
+	Name string `gorm:"uniqueIndex"`
+
+	// FirstSeenAt is the Unix timestamp (in seconds) for when the collection was first found orphaned.
+	FirstSeenAt int64
+}
+
+// CreateOrphanedCollection records an orphaned collection. It is a no-op if the collection has already been recorded,
+// e.g., by another server replica.
+func (s *S) CreateOrphanedCollection(oc *OrphanedCollection) error {
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(oc).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListOrphanedCollections lists the recorded orphaned collections.
+func (s *S) ListOrphanedCollections() ([]*OrphanedCollection, error) {
+	var ocs []*OrphanedCollection
+	if err := s.db.Order("id").Find(&ocs).Error; err != nil {
+		return nil, err
+	}
+	return ocs, nil
+}
+
+// DeleteOrphanedCollection deletes the record of an orphaned collection. It is a no-op if the collection has not been
+// recorded.
+func (s *S) DeleteOrphanedCollection(name string) error {
+	if err := s.db.Unscoped().Where("name = ?", name).Delete(&OrphanedCollection{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
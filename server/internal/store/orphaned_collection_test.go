@@ -0,0 +1,36 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrphanedCollection(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	err := st.CreateOrphanedCollection(&OrphanedCollection{Name: "vs_0", FirstSeenAt: 100})
+	assert.NoError(t, err)
+	err = st.CreateOrphanedCollection(&OrphanedCollection{Name: "vs_1", FirstSeenAt: 200})
+	assert.NoError(t, err)
+	// The first record is kept.
+	err = st.CreateOrphanedCollection(&OrphanedCollection{Name: "vs_0", FirstSeenAt: 300})
+	assert.NoError(t, err)
+
+	ocs, err := st.ListOrphanedCollections()
+	assert.NoError(t, err)
+	assert.Len(t, ocs, 2)
+	assert.Equal(t, "vs_0", ocs[0].Name)
+	assert.Equal(t, int64(100), ocs[0].FirstSeenAt)
+
+	err = st.DeleteOrphanedCollection("vs_0")
+	assert.NoError(t, err)
+	err = st.DeleteOrphanedCollection("unknown")
+	assert.NoError(t, err)
+
+	ocs, err = st.ListOrphanedCollections()
+	assert.NoError(t, err)
+	assert.Len(t, ocs, 1)
+	assert.Equal(t, "vs_1", ocs[0].Name)
+}
@@ -0,0 +1,26 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Project represents a project that owns vector stores. The row is created on demand and locked while the quotas of
+// the project are checked so that concurrent requests cannot exceed them.
+type Project struct {
+	gorm.Model
+
+	ProjectID string `gorm:"uniqueIndex"`
+}
+
+// LockProjectInTransaction locks the project until the transaction ends. The project is created if it does not exist.
+func LockProjectInTransaction(tx *gorm.DB, projectID string) error {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Project{ProjectID: projectID}).Error; err != nil {
+		return err
+	}
+	var p Project
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("project_id = ?", projectID).Take(&p).Error; err != nil {
+		return err
+	}
+	return nil
+}
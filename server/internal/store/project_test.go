@@ -0,0 +1,25 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestLockProjectInTransaction(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	for _, projectID := range []string{"p0", "p0", "p1"} {
+		err := st.Transaction(func(tx *gorm.DB) error {
+			return LockProjectInTransaction(tx, projectID)
+		})
+		assert.NoError(t, err)
+	}
+
+	var n int64
+	err := st.db.Model(&Project{}).Count(&n).Error
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+}
@@ -31,5 +31,10 @@ func autoMigrate(db *gorm.DB) error {
 		&Collection{},
 		&CollectionMetadata{},
 		&File{},
+		&FileAttribute{},
+		&FileBatch{},
+		&ChunkText{},
+		&Project{},
+		&OrphanedCollection{},
 	)
 }
@@ -0,0 +1,228 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	hfModelTypeWordPiece           = "WordPiece"
+	hfNormalizerTypeBert           = "BertNormalizer"
+	hfPreTokenizerTypeBert         = "BertPreTokenizer"
+	defaultContinuingSubwordPrefix = "##"
+	defaultMaxInputCharsPerWord    = 100
+)
+
+// hfTokenizerFile is the subset of the tokenizer.json of a Hugging Face model that is needed to count tokens.
+type hfTokenizerFile struct {
+	Normalizer *struct {
+		Type               string `json:"type"`
+		CleanText          *bool  `json:"clean_text"`
+		HandleChineseChars *bool  `json:"handle_chinese_chars"`
+		StripAccents       *bool  `json:"strip_accents"`
+		Lowercase          *bool  `json:"lowercase"`
+	} `json:"normalizer"`
+	PreTokenizer *struct {
+		Type string `json:"type"`
+	} `json:"pre_tokenizer"`
+	Model struct {
+		Type                    string         `json:"type"`
+		ContinuingSubwordPrefix string         `json:"continuing_subword_prefix"`
+		MaxInputCharsPerWord    int            `json:"max_input_chars_per_word"`
+		Vocab                   map[string]int `json:"vocab"`
+	} `json:"model"`
+}
+
+// LoadHuggingFace loads the tokenizer of a Hugging Face model from its tokenizer.json on the local disk.
+// Only WordPiece models with the BERT normalizer and pre-tokenizer (e.g., all-MiniLM and nomic-embed-text) are
+// supported.
+func LoadHuggingFace(path string) (T, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read tokenizer file: %s", err)
+	}
+	var f hfTokenizerFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("parse tokenizer file: %s", err)
+	}
+
+	if f.Model.Type != hfModelTypeWordPiece {
+		return nil, fmt.Errorf("unsupported model type %q", f.Model.Type)
+	}
+	if len(f.Model.Vocab) == 0 {
+		return nil, fmt.Errorf("empty vocabulary")
+	}
+	if p := f.PreTokenizer; p != nil && p.Type != hfPreTokenizerTypeBert {
+		return nil, fmt.Errorf("unsupported pre-tokenizer type %q", p.Type)
+	}
+
+	t := &wordPiece{
+		vocab:                f.Model.Vocab,
+		prefix:               f.Model.ContinuingSubwordPrefix,
+		maxInputCharsPerWord: f.Model.MaxInputCharsPerWord,
+	}
+	if t.prefix == "" {
+		t.prefix = defaultContinuingSubwordPrefix
+	}
+	if t.maxInputCharsPerWord <= 0 {
+		t.maxInputCharsPerWord = defaultMaxInputCharsPerWord
+	}
+	if n := f.Normalizer; n != nil {
+		if n.Type != hfNormalizerTypeBert {
+			return nil, fmt.Errorf("unsupported normalizer type %q", n.Type)
+		}
+		t.cleanText = boolOrDefault(n.CleanText, true)
+		t.handleChineseChars = boolOrDefault(n.HandleChineseChars, true)
+		t.lowercase = boolOrDefault(n.Lowercase, true)
+		// Accents are stripped when lowercasing unless explicitly specified.
+		t.stripAccents = boolOrDefault(n.StripAccents, t.lowercase)
+	}
+	return t, nil
+}
+
+func boolOrDefault(b *bool, d bool) bool {
+	if b == nil {
+		return d
+	}
+	return *b
+}
+
+// wordPiece is a WordPiece tokenizer compatible with the BERT tokenizer of Hugging Face.
+type wordPiece struct {
+	vocab                map[string]int
+	prefix               string
+	maxInputCharsPerWord int
+
+	cleanText          bool
+	handleChineseChars bool
+	stripAccents       bool
+	lowercase          bool
+}
+
+// CountTokens returns the number of tokens of a text.
+func (t *wordPiece) CountTokens(text string) int {
+	var n int
+	for _, w := range preTokenize(t.normalize(text)) {
+		n += t.countWordTokens(w)
+	}
+	return n
+}
+
+func (t *wordPiece) normalize(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if t.cleanText {
+			if r == 0 || r == unicode.ReplacementChar || isControl(r) {
+				continue
+			}
+			if unicode.IsSpace(r) {
+				r = ' '
+			}
+		}
+		if t.handleChineseChars && isCJK(r) {
+			sb.WriteRune(' ')
+			sb.WriteRune(r)
+			sb.WriteRune(' ')
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	s := sb.String()
+
+	if t.stripAccents {
+		var sb strings.Builder
+		for _, r := range norm.NFD.String(s) {
+			if unicode.Is(unicode.Mn, r) {
+				continue
+			}
+			sb.WriteRune(r)
+		}
+		s = sb.String()
+	}
+	if t.lowercase {
+		s = strings.ToLower(s)
+	}
+	return s
+}
+
+// preTokenize splits a text into words at whitespace. Each punctuation character is a separate word.
+func preTokenize(text string) []string {
+	var words []string
+	for _, f := range strings.Fields(text) {
+		start := 0
+		for i, r := range f {
+			if !isPunctuation(r) {
+				continue
+			}
+			if start < i {
+				words = append(words, f[start:i])
+			}
+			words = append(words, string(r))
+			start = i + len(string(r))
+		}
+		if start < len(f) {
+			words = append(words, f[start:])
+		}
+	}
+	return words
+}
+
+// countWordTokens returns the number of tokens of a word by greedily matching the longest subwords in the
+// vocabulary. A word that cannot be tokenized is counted as a single unknown token.
+func (t *wordPiece) countWordTokens(word string) int {
+	runes := []rune(word)
+	if len(runes) > t.maxInputCharsPerWord {
+		return 1
+	}
+	var n int
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		for ; end > start; end-- {
+			sub := string(runes[start:end])
+			if start > 0 {
+				sub = t.prefix + sub
+			}
+			if _, ok := t.vocab[sub]; ok {
+				break
+			}
+		}
+		if end == start {
+			return 1
+		}
+		n++
+		start = end
+	}
+	return n
+}
+
+func isControl(r rune) bool {
+	if r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return unicode.In(r, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+}
+
+func isPunctuation(r rune) bool {
+	// ASCII symbols such as "$" and "^" are treated as punctuation as well.
+	if (r >= 33 && r <= 47) || (r >= 58 && r <= 64) || (r >= 91 && r <= 96) || (r >= 123 && r <= 126) {
+		return true
+	}
+	return unicode.IsPunct(r)
+}
+
+// isCJK returns true if the rune is a CJK Unified Ideograph, which BERT tokenizes character by character.
+func isCJK(r rune) bool {
+	return (r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x20000 && r <= 0x2A6DF) ||
+		(r >= 0x2A700 && r <= 0x2B73F) ||
+		(r >= 0x2B740 && r <= 0x2B81F) ||
+		(r >= 0x2B820 && r <= 0x2CEAF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x2F800 && r <= 0x2FA1F)
+}
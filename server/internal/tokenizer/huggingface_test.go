@@ -0,0 +1,76 @@
+package tokenizer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWordPieceTokenizer = `{
+  "normalizer": {
+    "type": "BertNormalizer",
+    "clean_text": true,
+    "handle_chinese_chars": true,
+    "strip_accents": null,
+    "lowercase": true
+  },
+  "pre_tokenizer": {"type": "BertPreTokenizer"},
+  "model": {
+    "type": "WordPiece",
+    "unk_token": "[UNK]",
+    "continuing_subword_prefix": "##",
+    "max_input_chars_per_word": 10,
+    "vocab": {
+      "[UNK]": 0,
+      "hello": 1,
+      "world": 2,
+      "token": 3,
+      "##izer": 4,
+      "##s": 5,
+      "cafe": 6,
+      "!": 7,
+      ",": 8,
+      "東": 9,
+      "京": 10
+    }
+  }
+}`
+
+func TestLoadHuggingFace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokenizer.json")
+	err := os.WriteFile(path, []byte(testWordPieceTokenizer), 0600)
+	require.NoError(t, err)
+	tok, err := LoadHuggingFace(path)
+	require.NoError(t, err)
+
+	tcs := []struct {
+		text string
+		want int
+	}{
+		{text: "", want: 0},
+		{text: "hello world", want: 2},
+		{text: "Hello, World!", want: 4},
+		{text: "tokenizers", want: 3},
+		{text: "Café", want: 1},
+		{text: "東京", want: 2},
+		{text: "unknown", want: 1},
+		{text: "tokenizerstokenizers", want: 1},
+		{text: "hello\tworld\n", want: 2},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.text, func(t *testing.T) {
+			assert.Equal(t, tc.want, tok.CountTokens(tc.text))
+		})
+	}
+}
+
+func TestLoadHuggingFace_Unsupported(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokenizer.json")
+	err := os.WriteFile(path, []byte(`{"model": {"type": "BPE", "vocab": {"a": 0}}}`), 0600)
+	require.NoError(t, err)
+	_, err = LoadHuggingFace(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// tiktokenEncoding is the definition of a tiktoken encoding except for its BPE ranks.
+type tiktokenEncoding struct {
+	pattern       string
+	specialTokens map[string]int
+}
+
+// tiktokenEncodings are the supported encodings. They are copied from tiktoken-go, which downloads the BPE
+// files from the Internet.
+var tiktokenEncodings = map[string]tiktokenEncoding{
+	tiktoken.MODEL_CL100K_BASE: {
+		pattern: `(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+(?!\S)|\s+`,
+		specialTokens: map[string]int{
+			tiktoken.ENDOFTEXT:   100257,
+			tiktoken.FIM_PREFIX:  100258,
+			tiktoken.FIM_MIDDLE:  100259,
+			tiktoken.FIM_SUFFIX:  100260,
+			tiktoken.ENDOFPROMPT: 100276,
+		},
+	},
+	tiktoken.MODEL_P50K_BASE: {
+		pattern:       `'s|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+|\s+(?!\S)|\s+`,
+		specialTokens: map[string]int{tiktoken.ENDOFTEXT: 50256},
+	},
+	tiktoken.MODEL_R50K_BASE: {
+		pattern:       `'s|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+|\s+(?!\S)|\s+`,
+		specialTokens: map[string]int{tiktoken.ENDOFTEXT: 50256},
+	},
+}
+
+// LoadTiktoken loads a tiktoken tokenizer of the given encoding from a BPE file on the local disk.
+func LoadTiktoken(encoding, path string) (T, error) {
+	enc, ok := tiktokenEncodings[encoding]
+	if !ok {
+		var names []string
+		for n := range tiktokenEncodings {
+			names = append(names, n)
+		}
+		return nil, fmt.Errorf("tiktoken encoding must be one of: %v", names)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read tiktoken file: %s", err)
+	}
+	ranks, err := parseTiktokenBPE(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("parse tiktoken file: %s", err)
+	}
+
+	bpe, err := tiktoken.NewCoreBPE(ranks, enc.specialTokens, enc.pattern)
+	if err != nil {
+		return nil, fmt.Errorf("create tiktoken bpe: %s", err)
+	}
+	specialTokensSet := map[string]any{}
+	for t := range enc.specialTokens {
+		specialTokensSet[t] = true
+	}
+	return &tiktokenTokenizer{
+		t: tiktoken.NewTiktoken(bpe, &tiktoken.Encoding{
+			Name:           encoding,
+			PatStr:         enc.pattern,
+			MergeableRanks: ranks,
+			SpecialTokens:  enc.specialTokens,
+		}, specialTokensSet),
+	}, nil
+}
+
+// parseTiktokenBPE parses a BPE file where each line consists of a base64-encoded token and its rank.
+func parseTiktokenBPE(contents string) (map[string]int, error) {
+	ranks := map[string]int{}
+	for i, line := range strings.Split(contents, "\n") {
+		if line == "" {
+			continue
+		}
+		token, rank, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("line %d: missing rank", i+1)
+		}
+		t, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		r, err := strconv.Atoi(rank)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		ranks[string(t)] = r
+	}
+	if len(ranks) == 0 {
+		return nil, fmt.Errorf("no tokens")
+	}
+	return ranks, nil
+}
+
+type tiktokenTokenizer struct {
+	t *tiktoken.Tiktoken
+}
+
+// CountTokens returns the number of tokens of a text. Special tokens in the text are encoded as ordinary text.
+func (t *tiktokenTokenizer) CountTokens(text string) int {
+	return len(t.t.EncodeOrdinary(text))
+}
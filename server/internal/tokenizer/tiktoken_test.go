@@ -0,0 +1,51 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTiktoken(t *testing.T) {
+	// Build a BPE file with all single bytes and a few merged tokens.
+	var lines []string
+	for i := 0; i < 256; i++ {
+		lines = append(lines, fmt.Sprintf("%s %d", base64.StdEncoding.EncodeToString([]byte{byte(i)}), i))
+	}
+	for i, t := range []string{"he", "ll", "hell", "hello", " w", "or", " wor", " worl", " world"} {
+		lines = append(lines, fmt.Sprintf("%s %d", base64.StdEncoding.EncodeToString([]byte(t)), 256+i))
+	}
+	path := filepath.Join(t.TempDir(), "test.tiktoken")
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600)
+	require.NoError(t, err)
+
+	tok, err := LoadTiktoken("cl100k_base", path)
+	require.NoError(t, err)
+
+	tcs := []struct {
+		text string
+		want int
+	}{
+		{text: "", want: 0},
+		{text: "hello", want: 1},
+		{text: "hello world", want: 2},
+		{text: "hello there", want: 6},
+		{text: "123456", want: 6},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.text, func(t *testing.T) {
+			assert.Equal(t, tc.want, tok.CountTokens(tc.text))
+		})
+	}
+
+	_, err = LoadTiktoken("unknown", path)
+	assert.Error(t, err)
+	_, err = LoadTiktoken("cl100k_base", filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
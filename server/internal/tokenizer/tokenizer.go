@@ -0,0 +1,20 @@
+package tokenizer
+
+import (
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+)
+
+// T counts the tokens of texts.
+type T interface {
+	// CountTokens returns the number of tokens of a text. Special tokens added around the text by the model
+	// (e.g., [CLS] and [SEP]) are not counted.
+	CountTokens(text string) int
+}
+
+// Load loads the tokenizer of the configuration.
+func Load(c config.TokenizerConfig) (T, error) {
+	if c.HuggingFaceFile != "" {
+		return LoadHuggingFace(c.HuggingFaceFile)
+	}
+	return LoadTiktoken(c.TiktokenEncoding, c.TiktokenFile)
+}
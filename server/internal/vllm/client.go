@@ -2,9 +2,12 @@ package vllm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/go-logr/logr"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/sashabaranov/go-openai"
 )
 
@@ -34,11 +37,42 @@ func (c *Client) Embed(ctx context.Context, modelName, prompt string) ([]float32
 	}
 	resp, err := c.client.CreateEmbeddings(ctx, req)
 	if err != nil {
+		if isRateLimitError(err) {
+			return nil, fmt.Errorf("create embeddings: %w: %s", embedder.ErrRateLimitExceeded, err)
+		}
 		return nil, fmt.Errorf("create embeddings: %s", err)
 	}
 	return resp.Data[0].Embedding, nil
 }
 
+// EmbedBatch creates embeddings of multiple prompts in a single request.
+func (c *Client) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	req := openai.EmbeddingRequest{
+		Input:          prompts,
+		Model:          openai.EmbeddingModel(modelName),
+		EncodingFormat: openai.EmbeddingEncodingFormatFloat,
+	}
+	resp, err := c.client.CreateEmbeddings(ctx, req)
+	if err != nil {
+		if isRateLimitError(err) {
+			return nil, fmt.Errorf("create embeddings: %w: %s", embedder.ErrRateLimitExceeded, err)
+		}
+		return nil, fmt.Errorf("create embeddings: %s", err)
+	}
+	if len(resp.Data) != len(prompts) {
+		return nil, fmt.Errorf("create embeddings: got %d embeddings for %d prompts", len(resp.Data), len(prompts))
+	}
+	// The embeddings are not guaranteed to be in the order of the prompts.
+	es := make([][]float32, len(prompts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(prompts) {
+			return nil, fmt.Errorf("create embeddings: invalid index %d", d.Index)
+		}
+		es[d.Index] = d.Embedding
+	}
+	return es, nil
+}
+
 // PullModel pulls a model.
 func (c *Client) PullModel(ctx context.Context, modelName string) error {
 	resp, err := c.client.ListModels(ctx)
@@ -52,5 +86,17 @@ func (c *Client) PullModel(ctx context.Context, modelName string) error {
 		}
 	}
 	// TODO(guangrui): bring up a vLLM instance with the required model.
-	return fmt.Errorf("pulling model is not implemented in vLLM")
+	return fmt.Errorf("%w: pulling model is not implemented in vLLM", embedder.ErrUnknownModel)
+}
+
+func isRateLimitError(err error) bool {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) && reqErr.HTTPStatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return false
 }
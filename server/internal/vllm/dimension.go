@@ -4,7 +4,8 @@ import (
 	"fmt"
 )
 
-// Dimension returns the dimension of the model.
+// Dimension returns the dimension of well-known models. It is used as a fallback when the dimension cannot be
+// discovered from the LLM engine.
 func Dimension(model string) (int, error) {
 	dimsByModel := map[string]int{
 		"intfloat/e5-mistral-7b-instruct": 4096,
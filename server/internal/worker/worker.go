@@ -0,0 +1,343 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	fv1 "github.com/llmariner/file-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// defaultMaxAttempts is the maximum number of attempts to embed a file if it is not configured.
+const defaultMaxAttempts = 3
+
+// errUsageQuotaExceeded is returned by completeFile if the completed file makes the project exceed its usage quota.
+var errUsageQuotaExceeded = errors.New("usage quota exceeded")
+
+type fileInternalClient interface {
+	GetFilePath(ctx context.Context, in *fv1.GetFilePathRequest, opts ...grpc.CallOption) (*fv1.GetFilePathResponse, error)
+}
+
+type fileEmbedder interface {
+	AddFile(
+		ctx context.Context,
+		collectionName,
+		modelName,
+		fileID,
+		fileName,
+		filePath string,
+		chunking embedder.Chunking,
+		attributes map[string]string,
+	) (embedder.FileResult, error)
+	DeleteFile(ctx context.Context, collectionName, fileID string) error
+}
+
+// New creates a worker.
+func New(
+	store *store.S,
+	fileInternalClient fileInternalClient,
+	e fileEmbedder,
+	cfg config.IngestionConfig,
+	quotas config.QuotasConfig,
+	log logr.Logger,
+) *W {
+	return &W{
+		store:              store,
+		fileInternalClient: fileInternalClient,
+		embedder:           e,
+		cfg:                cfg,
+		quotas:             quotas,
+		log:                log.WithName("worker"),
+	}
+}
+
+// W is a worker that embeds files added to vector stores.
+//
+// Files are queued as in-progress rows in the database. Each worker goroutine claims a file by setting its
+// processing deadline with optimistic locking, so files are not processed twice even when multiple
+// server replicas are running. Files left in progress by a crashed or restarted server are picked up
+// again once their deadline passes, and are marked as failed after the maximum number of attempts.
+type W struct {
+	store              *store.S
+	fileInternalClient fileInternalClient
+	embedder           fileEmbedder
+	cfg                config.IngestionConfig
+	quotas             config.QuotasConfig
+	log                logr.Logger
+}
+
+// Run starts the worker goroutines and blocks until the context is canceled.
+func (w *W) Run(ctx context.Context) error {
+	w.log.Info("Starting workers...", "numWorkers", w.cfg.NumWorkers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.NumWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.runLoop(ctx)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+func (w *W) runLoop(ctx context.Context) {
+	for {
+		processed, err := w.processNextFile(ctx)
+		if err != nil {
+			w.log.Error(err, "Failed to process a file")
+		}
+		if processed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.cfg.PollingInterval):
+		}
+	}
+}
+
+// processNextFile claims a pending file and embeds it. It returns false if there is no pending file.
+func (w *W) processNextFile(ctx context.Context) (bool, error) {
+	f, err := w.claimFile()
+	if err != nil {
+		return false, err
+	}
+	if f == nil {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.cfg.ProcessingTimeout)
+	defer cancel()
+	return true, w.processFile(ctx, f)
+}
+
+// claimFile finds a pending file and holds it for the processing timeout. It returns nil if there is no file to claim.
+func (w *W) claimFile() (*store.File, error) {
+	now := time.Now()
+	fs, err := w.store.ListPendingFiles(now.Unix(), w.cfg.NumWorkers)
+	if err != nil {
+		return nil, fmt.Errorf("list pending files: %s", err)
+	}
+	for _, f := range fs {
+		f.ProcessingExpiresAt = now.Add(w.cfg.ProcessingTimeout).Unix()
+		f.Attempts++
+		if err := w.store.UpdateFile(f); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				// Another worker has claimed the file.
+				continue
+			}
+			return nil, fmt.Errorf("update file: %s", err)
+		}
+		f.Version++
+		return f, nil
+	}
+	return nil, nil
+}
+
+func (w *W) processFile(ctx context.Context, f *store.File) error {
+	log := w.log.WithValues("file", f.FileID, "store", f.VectorStoreID)
+
+	c, err := w.store.GetCollectionByVectorStoreIDWithoutProject(f.VectorStoreID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The vector store has been deleted.
+			log.Info("Vector store not found. Skipping the file")
+			return nil
+		}
+		return fmt.Errorf("get collection: %s", err)
+	}
+
+	if f.Attempts > 1 {
+		// The file was claimed before but not completed. Remove documents inserted by the previous attempt.
+		log.Info("Retrying the file. Deleting documents from the previous attempt")
+		if err := w.embedder.DeleteFile(ctx, c.VectorStoreID, f.FileID); err != nil {
+			return fmt.Errorf("delete file: %s", err)
+		}
+	}
+
+	if maxAttempts := w.maxAttempts(); f.Attempts > maxAttempts {
+		// The previous attempts did not complete, e.g., because the file crashed the server. Give up the file so
+		// that it does not hold a worker forever.
+		log.Info("Giving up the file after too many attempts", "attempts", f.Attempts-1)
+		f.Status = store.FileStatusFailed
+		f.LastErrorCode = store.LastErrorCodeServerError
+		f.LastErrorMessage = fmt.Sprintf("the file could not be processed in %d attempts", maxAttempts)
+		f.UsageBytes = 0
+	} else if err := w.addFile(ctx, c, f); err != nil {
+		if ctx.Err() != nil && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			// The server is shutting down. Leave the file in progress and release it so that it is retried without
+			// counting this attempt.
+			f.Attempts--
+			f.ProcessingExpiresAt = 0
+			if err := w.store.UpdateFile(f); err != nil {
+				log.Error(err, "Failed to release the file")
+			}
+			return fmt.Errorf("add file: %s", err)
+		}
+		log.Error(err, "Failed to add file to vector store")
+		f.Status = store.FileStatusFailed
+		f.LastErrorCode = toLastErrorCode(err)
+		f.LastErrorMessage = err.Error()
+		f.UsageBytes = 0
+	} else {
+		log.Info("Added file to vector store")
+		f.Status = store.FileStatusCompleted
+		f.LastErrorCode = store.LastErrorCodeNone
+		f.LastErrorMessage = ""
+	}
+	f.ProcessingExpiresAt = 0
+
+	err = w.completeFile(c, f)
+	if errors.Is(err, errUsageQuotaExceeded) {
+		log.Info("File exceeds the usage quota. Deleting the added documents")
+		if err := w.embedder.DeleteFile(ctx, c.VectorStoreID, f.FileID); err != nil {
+			return fmt.Errorf("delete file: %s", err)
+		}
+		f.Status = store.FileStatusFailed
+		f.LastErrorCode = store.LastErrorCodeServerError
+		f.LastErrorMessage = err.Error()
+		f.UsageBytes = 0
+		err = w.completeFile(c, f)
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			// The file has been deleted or claimed by another worker while being processed.
+			log.Info("File was updated while being processed. Deleting the added documents")
+			if err := w.embedder.DeleteFile(context.Background(), c.VectorStoreID, f.FileID); err != nil {
+				return fmt.Errorf("delete file: %s", err)
+			}
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (w *W) maxAttempts() int {
+	if w.cfg.MaxAttempts > 0 {
+		return w.cfg.MaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+func (w *W) addFile(ctx context.Context, c *store.Collection, f *store.File) error {
+	w.log.Info("Adding file to vector store", "file", f.FileID, "store", f.VectorStoreID)
+	resp, err := w.fileInternalClient.GetFilePath(ctx, &fv1.GetFilePathRequest{Id: f.FileID})
+	if err != nil {
+		return fmt.Errorf("get file path: %s", err)
+	}
+	f.Filename = resp.Filename
+
+	fas, err := w.store.ListFileAttributes(f.VectorStoreID, f.FileID)
+	if err != nil {
+		return fmt.Errorf("list file attributes: %s", err)
+	}
+	attrs := map[string]string{}
+	for _, fa := range fas {
+		attrs[fa.Key] = fa.Value
+	}
+	q := w.quotas.ForProject(c.ProjectID)
+	chunking := embedder.Chunking{
+		Auto:               f.ChunkingStrategyType == store.ChunkingStrategyTypeAuto,
+		MaxChunkSizeTokens: f.MaxChunkSizeTokens,
+		ChunkOverlapTokens: f.ChunkOverlapTokens,
+		MaxChunks:          q.MaxChunksPerFile,
+	}
+	if f.ChunkingStrategyType == store.ChunkingStrategyTypeSemantic {
+		chunking.Semantic = &embedder.SemanticChunking{
+			BreakpointPercentile: f.SemanticBreakpointPercentile,
+			BufferSize:           f.SemanticBufferSize,
+		}
+	}
+	res, err := w.embedder.AddFile(
+		ctx,
+		c.VectorStoreID,
+		c.EmbeddingModel,
+		f.FileID,
+		resp.Filename,
+		resp.Path,
+		chunking,
+		attrs,
+	)
+	if err != nil {
+		return err
+	}
+	f.UsageBytes = res.UsageBytes
+	if res.Chunking.Auto {
+		// Record the parameters chosen by the auto chunking strategy.
+		f.ChunkingMethod = string(res.Chunking.Method)
+		f.MaxChunkSizeTokens = res.Chunking.MaxChunkSizeTokens
+		f.ChunkOverlapTokens = res.Chunking.ChunkOverlapTokens
+	}
+	return nil
+}
+
+// toLastErrorCode classifies an ingestion error into an error code that is exposed to users.
+func toLastErrorCode(err error) store.LastErrorCode {
+	if errors.Is(err, embedder.ErrRateLimitExceeded) {
+		return store.LastErrorCodeRateLimitExceeded
+	}
+	return store.LastErrorCodeServerError
+}
+
+// completeFile updates the status of the file, the file counts and the usage of the collection, and the file counts of
+// the file batch. The counts are incremented atomically so that files of the same collection can be completed in
+// parallel. ErrConcurrentUpdate is returned if the file has been updated or deleted since it was claimed, and
+// errUsageQuotaExceeded is returned if the completed file makes the project exceed its usage quota.
+func (w *W) completeFile(c *store.Collection, f *store.File) error {
+	return w.store.Transaction(func(tx *gorm.DB) error {
+		if f.Status == store.FileStatusCompleted {
+			if err := w.validateUsageInTransaction(tx, c, f.UsageBytes); err != nil {
+				return err
+			}
+		}
+		if err := store.UpdateFileInTransaction(tx, f); err != nil {
+			return err
+		}
+
+		d := store.FileCountsDelta{InProgress: -1}
+		d.Add(f.Status, 1)
+		if err := store.IncrementCollectionFileCountsInTransaction(tx, c.VectorStoreID, d, f.UsageBytes); err != nil {
+			return fmt.Errorf("increment file counts: %s", err)
+		}
+		if f.BatchID == "" {
+			return nil
+		}
+		if err := store.IncrementFileBatchFileCountsInTransaction(tx, f.VectorStoreID, f.BatchID, d); err != nil {
+			return fmt.Errorf("increment file batch counts: %s", err)
+		}
+		return nil
+	})
+}
+
+// validateUsageInTransaction checks if the usage of the project stays within the quota after usage bytes are added.
+// The project is locked until the transaction ends so that files completed concurrently cannot exceed the quota.
+func (w *W) validateUsageInTransaction(tx *gorm.DB, c *store.Collection, usage int64) error {
+	maxUsage := w.quotas.ForProject(c.ProjectID).MaxUsageBytes
+	if maxUsage == 0 {
+		return nil
+	}
+	if err := store.LockProjectInTransaction(tx, c.ProjectID); err != nil {
+		return fmt.Errorf("lock project: %s", err)
+	}
+	total, err := store.GetTotalUsageBytesInTransaction(tx, c.ProjectID)
+	if err != nil {
+		return fmt.Errorf("get total usage bytes: %s", err)
+	}
+	if total+usage <= maxUsage {
+		return nil
+	}
+	return fmt.Errorf("the file uses %d bytes, exceeding the quota of %d bytes as the project has used %d bytes: %w", usage, maxUsage, total, errUsageQuotaExceeded)
+}
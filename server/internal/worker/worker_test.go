@@ -0,0 +1,629 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	fv1 "github.com/llmariner/file-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/milvus"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	fileID        = "file0"
+	vectorStoreID = "vs0"
+	projectID     = "project0"
+)
+
+func TestProcessNextFile(t *testing.T) {
+	tcs := []struct {
+		name          string
+		addErr        error
+		wantStatus    store.FileStatus
+		wantErrCode   store.LastErrorCode
+		wantCompleted int64
+		wantFailed    int64
+		wantUsage     int64
+	}{
+		{
+			name:          "completed",
+			wantStatus:    store.FileStatusCompleted,
+			wantErrCode:   store.LastErrorCodeNone,
+			wantCompleted: 1,
+			wantUsage:     1024,
+		},
+		{
+			name:        "failed",
+			addErr:      fmt.Errorf("embed error"),
+			wantStatus:  store.FileStatusFailed,
+			wantErrCode: store.LastErrorCodeServerError,
+			wantFailed:  1,
+		},
+		{
+			name:        "rate limited",
+			addErr:      fmt.Errorf("llm embed: %w", embedder.ErrRateLimitExceeded),
+			wantStatus:  store.FileStatusFailed,
+			wantErrCode: store.LastErrorCodeRateLimitExceeded,
+			wantFailed:  1,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			createCollectionAndFile(t, st)
+
+			e := &fakeEmbedder{addErr: tc.addErr, usageBytes: 1024}
+			w := newTestWorker(t, st, e)
+
+			processed, err := w.processNextFile(context.Background())
+			assert.NoError(t, err)
+			assert.True(t, processed)
+			assert.Equal(t, []string{fileID}, e.added)
+
+			f, err := st.GetFileByFileID(vectorStoreID, fileID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, f.Status)
+			assert.Equal(t, tc.wantErrCode, f.LastErrorCode)
+			if tc.addErr != nil {
+				assert.Equal(t, tc.addErr.Error(), f.LastErrorMessage)
+			}
+			assert.Equal(t, int64(0), f.ProcessingExpiresAt)
+			assert.Equal(t, tc.wantUsage, f.UsageBytes)
+
+			c, err := st.GetCollectionByVectorStoreID(projectID, vectorStoreID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantUsage, c.UsageBytes)
+			assert.Equal(t, int64(0), c.FileCountsInProgress)
+			assert.Equal(t, tc.wantCompleted, c.FileCountsCompleted)
+			assert.Equal(t, tc.wantFailed, c.FileCountsFailed)
+			assert.Equal(t, int64(1), c.FileCountsTotal)
+
+			// No more pending files.
+			processed, err = w.processNextFile(context.Background())
+			assert.NoError(t, err)
+			assert.False(t, processed)
+		})
+	}
+}
+
+func TestProcessNextFile_Attributes(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+	err := st.Transaction(func(tx *gorm.DB) error {
+		return store.CreateFileAttributeInTransaction(tx, &store.FileAttribute{
+			VectorStoreID: vectorStoreID,
+			FileID:        fileID,
+			Key:           "lang",
+			Value:         "en",
+		})
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w := newTestWorker(t, st, e)
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, map[string]string{"lang": "en"}, e.attributes)
+}
+
+func TestClaimFile(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+
+	w := newTestWorker(t, st, &fakeEmbedder{})
+	f, err := w.claimFile()
+	assert.NoError(t, err)
+	assert.NotNil(t, f)
+	assert.Equal(t, fileID, f.FileID)
+	assert.Equal(t, 1, f.Attempts)
+
+	// The file is held by the first claim.
+	f, err = w.claimFile()
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+}
+
+func TestProcessNextFile_Retry(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+
+	// Simulate a previous attempt whose processing deadline has passed.
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	f.Attempts = 1
+	f.ProcessingExpiresAt = time.Now().Add(-time.Minute).Unix()
+	err = st.UpdateFile(f)
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w := newTestWorker(t, st, e)
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, []string{fileID}, e.deleted)
+	assert.Equal(t, []string{fileID}, e.added)
+
+	f, err = st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusCompleted, f.Status)
+}
+
+func TestProcessNextFile_MaxAttempts(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+
+	// Simulate previous attempts that crashed the server.
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	f.Attempts = defaultMaxAttempts
+	f.ProcessingExpiresAt = time.Now().Add(-time.Minute).Unix()
+	err = st.UpdateFile(f)
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w := newTestWorker(t, st, e)
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Empty(t, e.added)
+	// The documents of the previous attempts are deleted.
+	assert.Equal(t, []string{fileID}, e.deleted)
+
+	f, err = st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusFailed, f.Status)
+	assert.Equal(t, store.LastErrorCodeServerError, f.LastErrorCode)
+	assert.Equal(t, "the file could not be processed in 3 attempts", f.LastErrorMessage)
+
+	c, err := st.GetCollectionByVectorStoreID(projectID, vectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), c.FileCountsInProgress)
+	assert.Equal(t, int64(1), c.FileCountsFailed)
+
+	// No more pending files.
+	processed, err = w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, processed)
+}
+
+func TestProcessNextFile_Shutdown(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := newTestWorker(t, st, &fakeEmbedder{addErr: context.Canceled})
+	processed, err := w.processNextFile(ctx)
+	assert.Error(t, err)
+	assert.True(t, processed)
+
+	// The file is released without counting the attempt.
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusInProgress, f.Status)
+	assert.Equal(t, 0, f.Attempts)
+	assert.Equal(t, int64(0), f.ProcessingExpiresAt)
+
+	// The file is not processed as a retry.
+	e := &fakeEmbedder{}
+	w = newTestWorker(t, st, e)
+	processed, err = w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Empty(t, e.deleted)
+	assert.Equal(t, []string{fileID}, e.added)
+}
+
+func TestProcessNextFile_Batch(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	const batchID = "batch0"
+
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:         1,
+		VectorStoreID:        vectorStoreID,
+		Name:                 "collection0",
+		Status:               store.CollectionStatusCompleted,
+		ProjectID:            projectID,
+		FileCountsInProgress: 1,
+		FileCountsTotal:      1,
+	})
+	assert.NoError(t, err)
+	err = st.Transaction(func(tx *gorm.DB) error {
+		return store.CreateFileBatchInTransaction(tx, &store.FileBatch{
+			BatchID:              batchID,
+			VectorStoreID:        vectorStoreID,
+			Status:               store.FileBatchStatusInProgress,
+			FileCountsInProgress: 1,
+			FileCountsTotal:      1,
+		})
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		BatchID:       batchID,
+		Status:        store.FileStatusInProgress,
+	})
+	assert.NoError(t, err)
+
+	w := newTestWorker(t, st, &fakeEmbedder{})
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	b, err := st.GetFileBatchByBatchID(vectorStoreID, batchID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileBatchStatusCompleted, b.Status)
+	assert.Equal(t, int64(0), b.FileCountsInProgress)
+	assert.Equal(t, int64(1), b.FileCountsCompleted)
+}
+
+func TestCompleteFile_StaleCollection(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	c := &store.Collection{
+		CollectionID:         1,
+		VectorStoreID:        vectorStoreID,
+		Name:                 "collection0",
+		Status:               store.CollectionStatusCompleted,
+		ProjectID:            projectID,
+		FileCountsInProgress: 2,
+		FileCountsTotal:      2,
+	}
+	err := st.CreateCollection(c)
+	assert.NoError(t, err)
+
+	// Complete the files with the same copy of the collection as parallel workers would do.
+	w := newTestWorker(t, st, &fakeEmbedder{})
+	for i, status := range []store.FileStatus{store.FileStatusCompleted, store.FileStatusFailed} {
+		f := &store.File{
+			FileID:        fmt.Sprintf("file%d", i),
+			VectorStoreID: vectorStoreID,
+			Status:        store.FileStatusInProgress,
+		}
+		err = st.CreateFile(f)
+		assert.NoError(t, err)
+
+		f.Status = status
+		f.UsageBytes = 100
+		err = w.completeFile(c, f)
+		assert.NoError(t, err)
+	}
+
+	got, err := st.GetCollectionByVectorStoreID(projectID, vectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), got.FileCountsInProgress)
+	assert.Equal(t, int64(1), got.FileCountsCompleted)
+	assert.Equal(t, int64(1), got.FileCountsFailed)
+	assert.Equal(t, int64(2), got.FileCountsTotal)
+	assert.Equal(t, int64(200), got.UsageBytes)
+}
+
+func TestProcessNextFile_AutoChunking(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+	// Replace the file with one that uses the auto chunking strategy.
+	err := st.DeleteFile(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:               fileID,
+		VectorStoreID:        vectorStoreID,
+		Status:               store.FileStatusInProgress,
+		ChunkingStrategyType: store.ChunkingStrategyTypeAuto,
+	})
+	assert.NoError(t, err)
+
+	w := newTestWorker(t, st, &fakeEmbedder{})
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusCompleted, f.Status)
+	assert.Equal(t, store.ChunkingStrategyTypeAuto, f.ChunkingStrategyType)
+	assert.Equal(t, string(embedder.ChunkingMethodSentences), f.ChunkingMethod)
+	assert.Equal(t, int64(512), f.MaxChunkSizeTokens)
+	assert.Equal(t, int64(128), f.ChunkOverlapTokens)
+}
+
+func TestProcessNextFile_SemanticChunking(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+	err := st.DeleteFile(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:                       fileID,
+		VectorStoreID:                vectorStoreID,
+		Status:                       store.FileStatusInProgress,
+		ChunkingStrategyType:         store.ChunkingStrategyTypeSemantic,
+		MaxChunkSizeTokens:           800,
+		SemanticBreakpointPercentile: 90,
+		SemanticBufferSize:           2,
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w := newTestWorker(t, st, e)
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	assert.Equal(t, embedder.Chunking{
+		MaxChunkSizeTokens: 800,
+		Semantic: &embedder.SemanticChunking{
+			BreakpointPercentile: 90,
+			BufferSize:           2,
+		},
+	}, e.chunking)
+}
+
+func TestProcessNextFile_SemanticChunkingRateLimited(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	createCollectionAndFile(t, st)
+	err := st.DeleteFile(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:                       fileID,
+		VectorStoreID:                vectorStoreID,
+		Status:                       store.FileStatusInProgress,
+		ChunkingStrategyType:         store.ChunkingStrategyTypeSemantic,
+		MaxChunkSizeTokens:           800,
+		SemanticBreakpointPercentile: 90,
+		SemanticBufferSize:           1,
+	})
+	assert.NoError(t, err)
+
+	// The embedding of the sentences to split the file is rejected.
+	e := embedder.New(
+		&rateLimitedLLMClient{},
+		&textS3Client{text: "The sky is blue. Cats like fish. Go is a programming language."},
+		&noopVStoreClient{},
+		st,
+		nil,
+		nil,
+		config.EmbeddingConfig{BatchSize: 2, Concurrency: 1, InsertBatchSize: 2},
+		testr.New(t),
+	)
+	w := newTestWorker(t, st, &fakeEmbedder{})
+	w.embedder = e
+	processed, err := w.processNextFile(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusFailed, f.Status)
+	assert.Equal(t, store.LastErrorCodeRateLimitExceeded, f.LastErrorCode)
+}
+
+func TestProcessNextFile_Quotas(t *testing.T) {
+	tcs := []struct {
+		name       string
+		quota      config.QuotaConfig
+		wantStatus store.FileStatus
+	}{
+		{
+			name:       "within quota",
+			quota:      config.QuotaConfig{MaxUsageBytes: 2048, MaxChunksPerFile: 10},
+			wantStatus: store.FileStatusCompleted,
+		},
+		{
+			name:       "usage exceeded",
+			quota:      config.QuotaConfig{MaxUsageBytes: 1000},
+			wantStatus: store.FileStatusFailed,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			createCollectionAndFile(t, st)
+
+			e := &fakeEmbedder{usageBytes: 1024}
+			w := newTestWorker(t, st, e)
+			w.quotas = config.QuotasConfig{
+				Projects: map[string]config.QuotaConfig{projectID: tc.quota},
+			}
+
+			processed, err := w.processNextFile(context.Background())
+			assert.NoError(t, err)
+			assert.True(t, processed)
+			assert.Equal(t, tc.quota.MaxChunksPerFile, e.chunking.MaxChunks)
+
+			f, err := st.GetFileByFileID(vectorStoreID, fileID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, f.Status)
+			c, err := st.GetCollectionByVectorStoreID(projectID, vectorStoreID)
+			assert.NoError(t, err)
+			if tc.wantStatus == store.FileStatusFailed {
+				// The chunks of the file are deleted.
+				assert.Equal(t, []string{fileID}, e.deleted)
+				assert.Equal(t, int64(0), c.UsageBytes)
+			} else {
+				assert.Empty(t, e.deleted)
+				assert.Equal(t, int64(1024), c.UsageBytes)
+			}
+		})
+	}
+}
+
+func newTestWorker(t *testing.T, st *store.S, e *fakeEmbedder) *W {
+	return New(
+		st,
+		&fakeFileInternalClient{
+			paths: map[string]string{
+				fileID: "path/file0.txt",
+			},
+		},
+		e,
+		config.IngestionConfig{
+			NumWorkers:        1,
+			PollingInterval:   time.Second,
+			ProcessingTimeout: time.Minute,
+		},
+		config.QuotasConfig{},
+		testr.New(t),
+	)
+}
+
+func createCollectionAndFile(t *testing.T, st *store.S) {
+	err := st.CreateCollection(&store.Collection{
+		CollectionID:         1,
+		VectorStoreID:        vectorStoreID,
+		Name:                 "collection0",
+		Status:               store.CollectionStatusCompleted,
+		ProjectID:            projectID,
+		FileCountsInProgress: 1,
+		FileCountsTotal:      1,
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		Status:        store.FileStatusInProgress,
+	})
+	assert.NoError(t, err)
+}
+
+type fakeFileInternalClient struct {
+	paths map[string]string
+}
+
+func (c *fakeFileInternalClient) GetFilePath(ctx context.Context, in *fv1.GetFilePathRequest, opts ...grpc.CallOption) (*fv1.GetFilePathResponse, error) {
+	path, ok := c.paths[in.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	return &fv1.GetFilePathResponse{
+		Path:     path,
+		Filename: "file0.txt",
+	}, nil
+}
+
+type fakeEmbedder struct {
+	addErr     error
+	added      []string
+	deleted    []string
+	attributes map[string]string
+	chunking   embedder.Chunking
+	usageBytes int64
+}
+
+func (e *fakeEmbedder) AddFile(
+	ctx context.Context,
+	collectionName,
+	modelName,
+	fileID,
+	fileName,
+	filePath string,
+	chunking embedder.Chunking,
+	attributes map[string]string,
+) (embedder.FileResult, error) {
+	e.added = append(e.added, fileID)
+	e.attributes = attributes
+	e.chunking = chunking
+	if e.addErr != nil {
+		return embedder.FileResult{}, e.addErr
+	}
+	if chunking.Auto {
+		chunking.Method = embedder.ChunkingMethodSentences
+		chunking.MaxChunkSizeTokens = 512
+		chunking.ChunkOverlapTokens = 128
+	}
+	return embedder.FileResult{Chunking: chunking, UsageBytes: e.usageBytes}, nil
+}
+
+func (e *fakeEmbedder) DeleteFile(ctx context.Context, collectionName, fileID string) error {
+	e.deleted = append(e.deleted, fileID)
+	return nil
+}
+
+type rateLimitedLLMClient struct{}
+
+func (c *rateLimitedLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	return nil, fmt.Errorf("embed: %w: 429 Too Many Requests", embedder.ErrRateLimitExceeded)
+}
+
+func (c *rateLimitedLLMClient) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	return nil, fmt.Errorf("embed: %w: 429 Too Many Requests", embedder.ErrRateLimitExceeded)
+}
+
+func (c *rateLimitedLLMClient) PullModel(ctx context.Context, modelName string) error {
+	return nil
+}
+
+type textS3Client struct {
+	text string
+}
+
+func (c *textS3Client) Download(ctx context.Context, w io.WriterAt, key string) error {
+	_, err := w.WriteAt([]byte(c.text), 0)
+	return err
+}
+
+type noopVStoreClient struct{}
+
+func (c *noopVStoreClient) InsertDocuments(
+	ctx context.Context,
+	collectionName string,
+	files,
+	texts []string,
+	metadata []milvus.ChunkMetadata,
+	vectors [][]float32,
+	attributes map[string]string,
+) ([]int64, error) {
+	return make([]int64, len(texts)), nil
+}
+
+func (c *noopVStoreClient) DeleteDocuments(ctx context.Context, collectionName, fileID string) error {
+	return nil
+}
+
+func (c *noopVStoreClient) Search(
+	ctx context.Context,
+	collectionName string,
+	index milvus.IndexConfig,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *milvus.Filter,
+	mode milvus.SearchMode,
+) ([]*milvus.SearchResult, error) {
+	return nil, nil
+}
@@ -30,6 +30,20 @@ export type VectorStore = {
   expiresAt?: string
   lastActiveAt?: string
   metadata?: {[key: string]: string}
+  indexConfig?: IndexConfig
+  embeddingModel?: string
+  embeddingDimensions?: number
+}
+
+export type IndexConfig = {
+  metricType?: string
+  indexType?: string
+  nlist?: number
+  nprobe?: number
+  m?: number
+  efConstruction?: number
+  ef?: number
+  searchList?: number
 }
 
 export type ChunkingStrategyStatic = {
@@ -37,9 +51,23 @@ export type ChunkingStrategyStatic = {
   chunkOverlapTokens?: string
 }
 
+export type ChunkingStrategyAuto = {
+  method?: string
+  maxChunkSizeTokens?: string
+  chunkOverlapTokens?: string
+}
+
+export type ChunkingStrategySemantic = {
+  breakpointPercentile?: number
+  bufferSize?: number
+  maxChunkSizeTokens?: string
+}
+
 export type ChunkingStrategy = {
   type?: string
   static?: ChunkingStrategyStatic
+  auto?: ChunkingStrategyAuto
+  semantic?: ChunkingStrategySemantic
 }
 
 export type CreateVectorStoreRequest = {
@@ -48,6 +76,8 @@ export type CreateVectorStoreRequest = {
   expiresAfter?: ExpiresAfter
   chunkingStrategy?: ChunkingStrategy
   metadata?: {[key: string]: string}
+  indexConfig?: IndexConfig
+  embeddingModel?: string
 }
 
 export type ListVectorStoresRequest = {
@@ -104,12 +134,14 @@ export type VectorStoreFile = {
   status?: string
   lastError?: VectorStoreFileError
   chunkingStrategy?: ChunkingStrategy
+  attributes?: {[key: string]: string}
 }
 
 export type CreateVectorStoreFileRequest = {
   vectorStoreId?: string
   fileId?: string
   chunkingStrategy?: ChunkingStrategy
+  attributes?: {[key: string]: string}
 }
 
 export type ListVectorStoreFilesRequest = {
@@ -145,14 +177,125 @@ export type DeleteVectorStoreFileResponse = {
   deleted?: boolean
 }
 
+export type VectorStoreFileBatchFileCounts = {
+  inProgress?: string
+  completed?: string
+  failed?: string
+  cancelled?: string
+  total?: string
+}
+
+export type VectorStoreFileBatch = {
+  id?: string
+  object?: string
+  createdAt?: string
+  vectorStoreId?: string
+  status?: string
+  fileCounts?: VectorStoreFileBatchFileCounts
+}
+
+export type CreateVectorStoreFileBatchRequest = {
+  vectorStoreId?: string
+  fileIds?: string[]
+  chunkingStrategy?: ChunkingStrategy
+  attributes?: {[key: string]: string}
+}
+
+export type GetVectorStoreFileBatchRequest = {
+  vectorStoreId?: string
+  batchId?: string
+}
+
+export type CancelVectorStoreFileBatchRequest = {
+  vectorStoreId?: string
+  batchId?: string
+}
+
+export type ListFilesInVectorStoreBatchRequest = {
+  vectorStoreId?: string
+  batchId?: string
+  limit?: number
+  order?: string
+  after?: string
+  before?: string
+  filter?: string
+}
+
+export type Filter = {
+  type?: string
+  key?: string
+  value?: string
+  filters?: Filter[]
+}
+
+export type VectorStoreSearchRequestRankingOptions = {
+  ranker?: string
+  scoreThreshold?: number
+  rerank?: boolean
+}
+
+export type VectorStoreSearchRequest = {
+  vectorStoreId?: string
+  query?: string
+  maxNumResults?: number
+  rankingOptions?: VectorStoreSearchRequestRankingOptions
+  rewriteQuery?: boolean
+  filters?: Filter
+  searchMode?: string
+}
+
+export type ChunkMetadata = {
+  page?: number
+  headings?: string[]
+  chunkIndex?: number
+  startOffset?: number
+  endOffset?: number
+}
+
+export type VectorStoreSearchResultContent = {
+  type?: string
+  text?: string
+}
+
+export type VectorStoreSearchResult = {
+  fileId?: string
+  filename?: string
+  score?: number
+  content?: VectorStoreSearchResultContent[]
+  metadata?: ChunkMetadata
+}
+
+export type VectorStoreSearchResponse = {
+  object?: string
+  searchQuery?: string
+  data?: VectorStoreSearchResult[]
+  hasMore?: boolean
+  nextPage?: string
+}
+
 export type SearchVectorStoreRequest = {
   vectorStoreId?: string
   query?: string
   numDocuments?: number
+  projectId?: string
+  tenantId?: string
+  filters?: Filter
+  searchMode?: string
+  rerank?: boolean
+}
+
+export type SearchResult = {
+  chunkId?: string
+  fileId?: string
+  filename?: string
+  score?: number
+  text?: string
+  metadata?: ChunkMetadata
 }
 
 export type SearchVectorStoreResponse = {
   documents?: string[]
+  results?: SearchResult[]
 }
 
 export class VectorStoreService {
@@ -186,6 +329,21 @@ export class VectorStoreService {
   static DeleteVectorStoreFile(req: DeleteVectorStoreFileRequest, initReq?: fm.InitReq): Promise<DeleteVectorStoreFileResponse> {
     return fm.fetchReq<DeleteVectorStoreFileRequest, DeleteVectorStoreFileResponse>(`/v1/vector_stores/${req["vectorStoreId"]}/files/${req["fileId"]}`, {...initReq, method: "DELETE"})
   }
+  static CreateVectorStoreFileBatch(req: CreateVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch> {
+    return fm.fetchReq<CreateVectorStoreFileBatchRequest, VectorStoreFileBatch>(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static GetVectorStoreFileBatch(req: GetVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch> {
+    return fm.fetchReq<GetVectorStoreFileBatchRequest, VectorStoreFileBatch>(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}?${fm.renderURLSearchParams(req, ["vectorStoreId", "batchId"])}`, {...initReq, method: "GET"})
+  }
+  static CancelVectorStoreFileBatch(req: CancelVectorStoreFileBatchRequest, initReq?: fm.InitReq): Promise<VectorStoreFileBatch> {
+    return fm.fetchReq<CancelVectorStoreFileBatchRequest, VectorStoreFileBatch>(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}/cancel`, {...initReq, method: "POST"})
+  }
+  static ListFilesInVectorStoreBatch(req: ListFilesInVectorStoreBatchRequest, initReq?: fm.InitReq): Promise<ListVectorStoreFilesResponse> {
+    return fm.fetchReq<ListFilesInVectorStoreBatchRequest, ListVectorStoreFilesResponse>(`/v1/vector_stores/${req["vectorStoreId"]}/file_batches/${req["batchId"]}/files?${fm.renderURLSearchParams(req, ["vectorStoreId", "batchId"])}`, {...initReq, method: "GET"})
+  }
+  static SearchVectorStore(req: VectorStoreSearchRequest, initReq?: fm.InitReq): Promise<VectorStoreSearchResponse> {
+    return fm.fetchReq<VectorStoreSearchRequest, VectorStoreSearchResponse>(`/v1/vector_stores/${req["vectorStoreId"]}/search`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
 export class VectorStoreInternalService {
   static SearchVectorStore(req: SearchVectorStoreRequest, initReq?: fm.InitReq): Promise<SearchVectorStoreResponse> {